@@ -3,7 +3,13 @@ module github.com/tamalsaha/table-printer
 go 1.16
 
 require (
+	golang.org/x/term v0.0.0-20210220032956-6a3ed077a48d
 	gomodules.xyz/pointer v0.0.0-20201105071923-daf60fa55209
 	k8s.io/api v0.21.0
+	k8s.io/apiextensions-apiserver v0.21.0
 	k8s.io/apimachinery v0.21.0
+	k8s.io/client-go v0.21.0
+	k8s.io/kube-aggregator v0.21.0
+	k8s.io/metrics v0.21.0
+	sigs.k8s.io/yaml v1.2.0
 )
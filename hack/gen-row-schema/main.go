@@ -0,0 +1,28 @@
+// gen-row-schema writes the JSON Schema of the documents written by
+// printers.JSONWriter to the given file.
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/tamalsaha/table-printer/printers"
+)
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: gen-row-schema <file>")
+		os.Exit(1)
+	}
+
+	data, err := printers.RowJSONSchema()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if err := ioutil.WriteFile(os.Args[1], append(data, '\n'), 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -1,5 +1,390 @@
+// table-printer prints Kubernetes objects read from files or stdin as
+// tables, like kubectl get.
 package main
 
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tamalsaha/table-printer/printers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type writer interface {
+	Write(out io.Writer, tables ...printers.Table) error
+}
+
 func main() {
+	output := flag.String("o", "", "Output format: wide, extended, json, yaml, csv, tsv, markdown, html or custom-columns=<spec>.")
+	sortBy := flag.String("sort-by", "", "Column or JSONPath expression to sort rows by.")
+	filter := flag.String("filter", "", `Only print rows matching an expression, e.g. 'Status != "Running" && Restarts > 3'.`)
+	labelSelector := flag.String("l", "", "Label selector to filter objects on, e.g. 'app=web,tier!=cache'.")
+	fieldSelector := flag.String("field-selector", "", "Field selector to filter objects on, e.g. 'status.phase=Running'.")
+	groupBy := flag.String("group-by", "", "Print a row per group of rows with the same values of these columns, e.g. 'Node,Status'.")
+	aggregate := flag.String("aggregate", "count", "Columns of grouped rows, e.g. 'count,sum(Restarts)'.")
+	summarize := flag.Bool("summarize", false, "Print a single row with the aggregate columns of all rows.")
+	describe := flag.Bool("describe", false, "Describe objects like kubectl describe. Events among the objects are listed with the objects they involve.")
+	diff := flag.String("diff", "", "File or directory with an earlier snapshot of the objects. Print the rows that were added, removed or changed since.")
+	containers := flag.Bool("containers", false, "Print a sub-row per init, regular and ephemeral container under each pod.")
+	tree := flag.Bool("tree", false, "Print objects as trees of their owner references, e.g. Deployment → ReplicaSet → Pod.")
+	noHeaders := flag.Bool("no-headers", false, "Do not print headers.")
+	raw := flag.Bool("raw", false, "Write raw values, e.g. timestamps and counts, instead of display text with -o csv and -o tsv.")
+	color := flag.String("color", "auto", "Color cells by health: auto, always or never. auto colors only terminals and honors NO_COLOR.")
+	watchMode := flag.Bool("watch", false, "Read a newline-delimited JSON watch stream and print a row per event.")
+	live := flag.Bool("live", false, "Read a newline-delimited JSON watch stream and show it as a live, full-screen table.")
+	watchEvents := flag.Bool("output-watch-events", false, "Add an EVENT column in watch mode.")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: table-printer [flags] [file|directory...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	colorMode, err := parseColorMode(*color)
+	if err != nil {
+		fatal(err)
+	}
+
+	selector, err := printers.ParseSelector(*labelSelector, *fieldSelector)
+	if err != nil {
+		fatal(err)
+	}
+
+	if *live {
+		if err := runLive(*output, selector, colorMode); err != nil {
+			fatal(err)
+		}
+		return
+	}
+
+	if *watchMode {
+		priority := printers.PriorityDefault
+		if *output == "wide" {
+			priority = printers.PriorityWide
+		} else if *output != "" {
+			fatal(fmt.Errorf("output format %q is not supported in watch mode", *output))
+		}
+		w := printers.NewWatchWriter(os.Stdout, priority)
+		w.NoHeaders = *noHeaders
+		w.WithEventType = *watchEvents
+		w.Color = colorMode
+		if err := decodeWatchEvents(selector, w.Write); err != nil {
+			fatal(err)
+		}
+		return
+	}
+
+	if *containers {
+		printers.Register(printers.PodPrinter{ContainerDetails: true})
+	}
+
+	objs, err := readObjects(flag.Args())
+	if err != nil {
+		fatal(err)
+	}
+	// events are not printed themselves, but attached to the objects they
+	// involve
+	objs, events := printers.SplitEvents(objs)
+	objs = skipUnknownKinds(objs)
+	if objs, err = printers.SelectObjects(objs, selector); err != nil {
+		fatal(err)
+	}
+
+	priority := printers.PriorityDefault
+	var columns []printers.CustomColumn
+	var w writer = printers.TextWriter{NoHeaders: *noHeaders, WithKind: true, Color: colorMode}
+	switch format := *output; {
+	case format == "":
+	case format == "wide":
+		priority = printers.PriorityWide
+	case format == "extended":
+		priority = printers.PriorityExtended
+	case format == "json":
+		w = printers.JSONWriter{}
+	case format == "yaml":
+		w = printers.JSONWriter{YAML: true}
+	case format == "csv":
+		w = printers.CSVWriter{NoHeaders: *noHeaders, WithKind: true, WithNamespace: true, Raw: *raw}
+	case format == "tsv":
+		w = printers.CSVWriter{Comma: '\t', NoHeaders: *noHeaders, WithKind: true, WithNamespace: true, Raw: *raw}
+	case format == "markdown":
+		w = printers.MarkdownWriter{GroupByKind: true}
+	case format == "html":
+		w = printers.HTMLWriter{GroupByKind: true}
+	case strings.HasPrefix(format, "custom-columns="):
+		columns, err = printers.ParseCustomColumns(strings.TrimPrefix(format, "custom-columns="))
+		if err != nil {
+			fatal(err)
+		}
+		priority = printers.PriorityExtended
+	default:
+		fatal(fmt.Errorf("unknown output format %q", format))
+	}
+	if _, ok := w.(printers.CSVWriter); *raw && !ok {
+		fatal(fmt.Errorf("-raw is only supported with -o csv and -o tsv"))
+	}
+
+	if *describe {
+		if err := printers.DescribeObjects(os.Stdout, objs, events); err != nil {
+			fatal(err)
+		}
+		return
+	}
+
+	if *tree {
+		roots, err := printers.NewOwnershipTree(priority, objs...)
+		if err != nil {
+			fatal(err)
+		}
+		w := printers.TreeWriter{NoHeaders: *noHeaders, WithNamespace: true, Color: colorMode}
+		if err := w.Write(os.Stdout, roots...); err != nil {
+			fatal(err)
+		}
+		return
+	}
+
+	var rowFilter *printers.Filter
+	if *filter != "" {
+		if rowFilter, err = printers.ParseFilter(*filter); err != nil {
+			fatal(err)
+		}
+	}
+
+	var group *printers.GroupBy
+	if *groupBy != "" || *summarize {
+		aggs, err := printers.ParseAggregations(*aggregate)
+		if err != nil {
+			fatal(err)
+		}
+		if _, ok := w.(printers.JSONWriter); ok {
+			fatal(fmt.Errorf("grouped rows have no row schema and cannot be printed as %s", *output))
+		}
+		group = &printers.GroupBy{Aggregations: aggs}
+		if *groupBy != "" {
+			group.Keys = strings.Split(*groupBy, ",")
+		}
+		// group by any column, the grouped table has its own
+		priority = printers.PriorityExtended
+	}
+
+	var tables []printers.Table
+	if *diff != "" {
+		if _, ok := w.(printers.JSONWriter); ok {
+			fatal(fmt.Errorf("diffs have no row schema and cannot be printed as %s", *output))
+		}
+		before, err := readObjects([]string{*diff})
+		if err != nil {
+			fatal(err)
+		}
+		before, _ = printers.SplitEvents(before)
+		before = skipUnknownKinds(before)
+		if before, err = printers.SelectObjects(before, selector); err != nil {
+			fatal(err)
+		}
+		tables, err = printers.DiffTables(priority, before, objs, false)
+		if err != nil {
+			fatal(err)
+		}
+	} else {
+		tables, err = printers.NewUsageTables(priority, objs...)
+		if err != nil {
+			fatal(err)
+		}
+	}
+	for i := range tables {
+		if len(events) > 0 {
+			tables[i] = printers.WithLastEvents(tables[i], events)
+		}
+		if rowFilter != nil {
+			if tables[i], err = printers.FilterRows(tables[i], rowFilter); err != nil {
+				fatal(err)
+			}
+		}
+		if group != nil {
+			if tables[i], err = printers.GroupRows(tables[i], *group); err != nil {
+				fatal(err)
+			}
+		}
+		if *sortBy != "" {
+			if err := printers.SortRows(tables[i], *sortBy, false); err != nil {
+				fatal(err)
+			}
+		}
+		if columns != nil {
+			if tables[i], err = printers.SelectColumns(tables[i], columns); err != nil {
+				fatal(err)
+			}
+		}
+	}
+	if err := w.Write(os.Stdout, tables...); err != nil {
+		fatal(err)
+	}
+}
+
+// runLive shows the watch stream read from stdin in a LiveView. Keys are
+// read from the terminal, since stdin is taken by the stream.
+func runLive(output string, selector printers.Selector, color printers.ColorMode) error {
+	priority := printers.PriorityDefault
+	if output == "wide" {
+		priority = printers.PriorityWide
+	} else if output != "" {
+		return fmt.Errorf("output format %q is not supported in live mode", output)
+	}
+
+	tty, err := os.Open("/dev/tty")
+	if err != nil {
+		return err
+	}
+	defer tty.Close()
+
+	events := make(chan watch.Event)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(events)
+		err := decodeWatchEvents(selector, func(e watch.Event) error {
+			events <- e
+			return nil
+		})
+		if err != nil {
+			// show the error in the status line of the view
+			events <- watch.Event{Type: watch.Error, Object: &metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}}
+		}
+		errc <- err
+	}()
+
+	v := printers.NewLiveView(priority)
+	v.Color = color
+	if err := v.Run(events, tty, os.Stdout); err != nil {
+		return err
+	}
+	select {
+	case err := <-errc:
+		return err
+	default:
+		// quit before the end of the stream
+		return nil
+	}
+}
+
+// decodeWatchEvents calls fn for the events read from stdin whose object is
+// selected. Events of kinds without a converter are skipped with a warning.
+func decodeWatchEvents(selector printers.Selector, fn func(watch.Event) error) error {
+	warned := map[schema.GroupVersionKind]bool{}
+	return printers.DecodeWatchEvents(os.Stdin, func(e watch.Event) error {
+		switch e.Type {
+		case watch.Added, watch.Modified, watch.Deleted:
+			if gvk := e.Object.GetObjectKind().GroupVersionKind(); !printers.HasConverter(e.Object) {
+				if !warned[gvk] {
+					warned[gvk] = true
+					warnf("skipping %s (%s) objects: no column converter registered", gvk.Kind, gvk.GroupVersion())
+				}
+				return nil
+			}
+			if ok, err := selector.Matches(e.Object); err != nil || !ok {
+				return err
+			}
+		}
+		return fn(e)
+	})
+}
+
+// skipUnknownKinds returns the objects of objs that have a converter, and
+// warns once per kind about the others.
+func skipUnknownKinds(objs []runtime.Object) []runtime.Object {
+	var result []runtime.Object
+	var kinds []schema.GroupVersionKind
+	skipped := map[schema.GroupVersionKind]int{}
+	for _, o := range objs {
+		if printers.HasConverter(o) {
+			result = append(result, o)
+			continue
+		}
+		gvk := o.GetObjectKind().GroupVersionKind()
+		if skipped[gvk] == 0 {
+			kinds = append(kinds, gvk)
+		}
+		skipped[gvk]++
+	}
+	for _, gvk := range kinds {
+		warnf("skipping %d %s (%s) objects: no column converter registered", skipped[gvk], gvk.Kind, gvk.GroupVersion())
+	}
+	return result
+}
+
+// readObjects reads the objects of files, or of stdin if there are none.
+// Directories are read recursively, their .yaml, .yml and .json files in
+// lexical order.
+func readObjects(files []string) ([]runtime.Object, error) {
+	if len(files) == 0 {
+		return printers.DecodeObjects(os.Stdin)
+	}
+
+	var names []string
+	for _, name := range files {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			names = append(names, name)
+			continue
+		}
+		err = filepath.Walk(name, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			switch filepath.Ext(path) {
+			case ".yaml", ".yml", ".json":
+				if !info.IsDir() {
+					names = append(names, path)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var objs []runtime.Object
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		items, err := printers.DecodeObjects(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		objs = append(objs, items...)
+	}
+	return objs, nil
+}
+
+func parseColorMode(s string) (printers.ColorMode, error) {
+	switch s {
+	case "auto":
+		return printers.ColorAuto, nil
+	case "always":
+		return printers.ColorAlways, nil
+	case "never":
+		return printers.ColorNever, nil
+	}
+	return 0, fmt.Errorf("unknown color mode %q, expected auto, always or never", s)
+}
+
+func warnf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
+}
 
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
 }
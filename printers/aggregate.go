@@ -0,0 +1,286 @@
+package printers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// AggregateFunc computes a value over the rows of a group.
+type AggregateFunc string
+
+const (
+	AggregateCount AggregateFunc = "count"
+	AggregateSum   AggregateFunc = "sum"
+	AggregateAvg   AggregateFunc = "avg"
+	AggregateMin   AggregateFunc = "min"
+	AggregateMax   AggregateFunc = "max"
+)
+
+// Aggregation is a column of a grouped table. Column is the name of a
+// converter column or a JSONPath expression, and is not used by count.
+type Aggregation struct {
+	Header string
+	Func   AggregateFunc
+	Column string
+}
+
+// GroupBy describes a grouped table: a row per distinct combination of the
+// Keys, which are column names or JSONPath expressions, with a column per
+// key and per aggregation. Without keys all rows form a single summary row.
+type GroupBy struct {
+	Keys         []string
+	Aggregations []Aggregation
+}
+
+// ParseAggregations parses a list of aggregations, e.g.
+// "count,sum(Restarts),MOST:max(Restarts)". Headers default to "Count" and to
+// the function and column, e.g. "Sum Restarts".
+func ParseAggregations(spec string) ([]Aggregation, error) {
+	var aggs []Aggregation
+	for _, part := range strings.Split(spec, ",") {
+		header := ""
+		if idx := strings.Index(part, ":"); idx >= 0 {
+			header, part = part[:idx], part[idx+1:]
+		}
+
+		agg := Aggregation{Header: header}
+		if idx := strings.Index(part, "("); idx >= 0 && strings.HasSuffix(part, ")") {
+			agg.Func = AggregateFunc(strings.ToLower(part[:idx]))
+			agg.Column = part[idx+1 : len(part)-1]
+		} else {
+			agg.Func = AggregateFunc(strings.ToLower(part))
+		}
+		switch agg.Func {
+		case AggregateCount:
+		case AggregateSum, AggregateAvg, AggregateMin, AggregateMax:
+			if agg.Column == "" {
+				return nil, fmt.Errorf("unexpected aggregation %q, expected %s(<column>)", part, agg.Func)
+			}
+		default:
+			return nil, fmt.Errorf("unknown aggregation %q, expected one of count, sum, avg, min or max", part)
+		}
+		aggs = append(aggs, agg)
+	}
+	return aggs, nil
+}
+
+func (a Aggregation) header() string {
+	if a.Header != "" {
+		return a.Header
+	}
+	if a.Func == AggregateCount {
+		return "Count"
+	}
+	return strings.Title(string(a.Func)) + " " + a.Column
+}
+
+type groupSource struct {
+	col metav1.TableColumnDefinition
+	jp  *jsonpath.JSONPath
+}
+
+// GroupRows returns a derived table with a row per group of rows of t, in
+// the order the groups are first seen. Rows are grouped by the display text
+// of their key cells. The rows of the result have no Object, and its kind,
+// e.g. PodSummary, has no row schema, so JSONWriter does not write it.
+func GroupRows(t Table, g GroupBy) (Table, error) {
+	result := Table{GVK: t.GVK}
+	result.GVK.Kind += "Summary"
+
+	keys := make([]groupSource, len(g.Keys))
+	for i, key := range g.Keys {
+		src, err := groupSourceFor(t, key)
+		if err != nil {
+			return Table{}, err
+		}
+		keys[i] = src
+		col := src.col
+		if col.Format == "name" {
+			// key cells are not names of objects of the derived table
+			col.Format = ""
+		}
+		result.Columns = append(result.Columns, col)
+	}
+
+	aggs := make([]groupSource, len(g.Aggregations))
+	for i, agg := range g.Aggregations {
+		col := metav1.TableColumnDefinition{Name: agg.header(), Type: "integer"}
+		if agg.Func != AggregateCount {
+			src, err := groupSourceFor(t, agg.Column)
+			if err != nil {
+				return Table{}, err
+			}
+			aggs[i] = src
+			col.Type = src.col.Type
+			if agg.Func == AggregateAvg {
+				col.Type = "number"
+			}
+		}
+		col.Description = "The number of rows of the group."
+		if agg.Func != AggregateCount {
+			col.Description = fmt.Sprintf("The %s of %s over the rows of the group.", agg.Func, agg.Column)
+		}
+		result.Columns = append(result.Columns, col)
+	}
+
+	var groups [][]Row
+	index := map[string]int{}
+	for _, row := range t.Rows {
+		var id strings.Builder
+		for _, src := range keys {
+			v, err := src.value(row)
+			if err != nil {
+				return Table{}, err
+			}
+			id.WriteString(strconv.Quote(DisplayValue(v)))
+		}
+		i, ok := index[id.String()]
+		if !ok {
+			i = len(groups)
+			index[id.String()] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], row)
+	}
+	if len(keys) == 0 && len(groups) == 0 {
+		// a summary of no rows is still a row of zeros
+		groups = append(groups, nil)
+	}
+
+	for _, rows := range groups {
+		cells := map[string]interface{}{}
+		for i, src := range keys {
+			v, err := src.value(rows[0])
+			if err != nil {
+				return Table{}, err
+			}
+			cells[result.Columns[i].Name] = v
+		}
+		for i, agg := range g.Aggregations {
+			v, err := aggregate(agg.Func, aggs[i], rows)
+			if err != nil {
+				return Table{}, err
+			}
+			cells[result.Columns[len(keys)+i].Name] = v
+		}
+		result.Rows = append(result.Rows, Row{Cells: cells, Health: Health{Level: HealthUnknown}})
+	}
+	return result, nil
+}
+
+func groupSourceFor(t Table, spec string) (groupSource, error) {
+	if isJSONPath(spec) {
+		jp, err := parseJSONPath(spec)
+		if err != nil {
+			return groupSource{}, err
+		}
+		return groupSource{col: metav1.TableColumnDefinition{Name: spec, Type: "string"}, jp: jp}, nil
+	}
+	col, ok := findColumn(t.Columns, spec)
+	if !ok {
+		if strings.EqualFold(spec, "Namespace") {
+			// most kinds do not have a Namespace column, kubectl adds one
+			jp, _ := parseJSONPath(".metadata.namespace")
+			return groupSource{col: metav1.TableColumnDefinition{Name: "Namespace", Type: "string"}, jp: jp}, nil
+		}
+		return groupSource{}, fmt.Errorf("column %q not found for %v", spec, t.GVK)
+	}
+	return groupSource{col: col}, nil
+}
+
+func (s groupSource) value(row Row) (interface{}, error) {
+	if s.jp != nil {
+		return evalJSONPath(s.jp, row.Object)
+	}
+	return row.Cells[s.col.Name], nil
+}
+
+// aggregate computes fn over the values of src in rows. Sums and averages
+// use the raw values of cells, which must all be numbers, all durations or
+// all resource quantities; missing values are skipped. Min and max return
+// the smallest and largest cell in the order of SortRows.
+func aggregate(fn AggregateFunc, src groupSource, rows []Row) (interface{}, error) {
+	if fn == AggregateCount {
+		return int64(len(rows)), nil
+	}
+
+	var (
+		best  interface{}
+		sum   float64
+		total resource.Quantity
+		n     int
+		kind  = sortMissing
+	)
+	for _, row := range rows {
+		v, err := src.value(row)
+		if err != nil {
+			return nil, err
+		}
+		k := sortKeyOf(v)
+		if k.kind == sortMissing {
+			continue
+		}
+		switch fn {
+		case AggregateMin, AggregateMax:
+			if c := compareValues(v, best); best == nil || fn == AggregateMin && c < 0 || fn == AggregateMax && c > 0 {
+				best = v
+			}
+			continue
+		}
+
+		switch k.kind {
+		case sortNumber, sortDuration, sortQuantity:
+		default:
+			return nil, fmt.Errorf("cannot %s %s: %q is not a number, duration or quantity", fn, src.col.Name, DisplayValue(v))
+		}
+		if kind != sortMissing && kind != k.kind {
+			return nil, fmt.Errorf("cannot %s %s: it mixes numbers, durations and quantities", fn, src.col.Name)
+		}
+		kind = k.kind
+		if k.kind == sortQuantity {
+			q, _ := RawValue(v).(resource.Quantity)
+			total.Add(q)
+		}
+		sum += k.num
+		n++
+	}
+
+	switch fn {
+	case AggregateMin, AggregateMax:
+		return best, nil
+	}
+	if n == 0 {
+		if fn == AggregateAvg {
+			return nil, nil
+		}
+		return int64(0), nil
+	}
+	if fn == AggregateAvg {
+		switch kind {
+		case sortDuration:
+			d := time.Duration(sum / float64(n))
+			return Cell{Raw: d, Display: duration.HumanDuration(d)}, nil
+		case sortQuantity:
+			avg := resource.NewMilliQuantity(int64(total.AsApproximateFloat64()*1000/float64(n)), total.Format)
+			return quantityCell(avg), nil
+		}
+		return Cell{Raw: sum / float64(n), Display: strconv.FormatFloat(sum/float64(n), 'f', 2, 64)}, nil
+	}
+	switch kind {
+	case sortDuration:
+		return Cell{Raw: time.Duration(sum), Display: duration.HumanDuration(time.Duration(sum))}, nil
+	case sortQuantity:
+		return quantityCell(&total), nil
+	}
+	if sum == float64(int64(sum)) {
+		return int64(sum), nil
+	}
+	return sum, nil
+}
@@ -0,0 +1,215 @@
+package printers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGroupRows(t *testing.T) {
+	quantity := func(s string) Cell {
+		q := resource.MustParse(s)
+		return quantityCell(&q)
+	}
+	uptime := func(d time.Duration) Cell {
+		return Cell{Raw: d, Display: d.String()}
+	}
+	row := func(ns string, cells map[string]interface{}) Row {
+		o := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: DisplayValue(cells["Name"])}}
+		return Row{Object: o, Cells: cells}
+	}
+
+	workers := Table{
+		GVK: schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Worker"},
+		Columns: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Zone", Type: "string"},
+			{Name: "Jobs", Type: "integer"},
+			{Name: "Load", Type: "number"},
+			{Name: "Uptime", Type: "string"},
+			{Name: "Memory", Type: "string"},
+			{Name: "State", Type: "string"},
+		},
+		Rows: []Row{
+			row("ci", map[string]interface{}{"Name": "w1", "Zone": "eu-1", "Jobs": int64(4), "Load": 0.5, "Uptime": uptime(90 * time.Minute), "Memory": quantity("512Mi"), "State": "Idle"}),
+			row("ci", map[string]interface{}{"Name": "w2", "Zone": "eu-1", "Jobs": int32(2), "Load": 2, "Uptime": uptime(30 * time.Minute), "Memory": quantity("1Gi"), "State": "Busy"}),
+			row("batch", map[string]interface{}{"Name": "w3", "Zone": "us-2", "Jobs": PodRestarts{Count: 7}, "Load": nil, "Uptime": uptime(2 * time.Hour), "Memory": quantity("256Mi"), "State": "Busy"}),
+			row("batch", map[string]interface{}{"Name": "w4", "Zone": "eu-1", "Jobs": "<none>", "Load": Cell{Raw: 1.25, Display: "1.25"}, "Memory": quantity("2Gi"), "State": "Draining"}),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		t       Table
+		g       GroupBy
+		columns []string
+		rows    [][]string
+		err     string
+	}{
+		{
+			name: "integers of several types",
+			t:    workers,
+			g: GroupBy{Keys: []string{"Zone"}, Aggregations: []Aggregation{
+				{Func: AggregateCount},
+				{Func: AggregateSum, Column: "Jobs"},
+				{Header: "FEWEST", Func: AggregateMin, Column: "Jobs"},
+				{Func: AggregateMax, Column: "Jobs"},
+			}},
+			columns: []string{"Zone", "Count", "Sum Jobs", "FEWEST", "Max Jobs"},
+			rows: [][]string{
+				{"eu-1", "3", "6", "2", "4"},
+				{"us-2", "1", "7", "7", "7"},
+			},
+		},
+		{
+			name: "floats, ints and missing values",
+			t:    workers,
+			g: GroupBy{Keys: []string{"zone"}, Aggregations: []Aggregation{
+				{Func: AggregateAvg, Column: "Load"},
+				{Func: AggregateSum, Column: "Load"},
+			}},
+			columns: []string{"Zone", "Avg Load", "Sum Load"},
+			rows: [][]string{
+				{"eu-1", "1.25", "3.75"},
+				{"us-2", "<none>", "0"},
+			},
+		},
+		{
+			name: "durations and quantities",
+			t:    workers,
+			g: GroupBy{Aggregations: []Aggregation{
+				{Func: AggregateSum, Column: "Uptime"},
+				{Func: AggregateAvg, Column: "Uptime"},
+				{Func: AggregateSum, Column: "Memory"},
+				{Func: AggregateMax, Column: "Memory"},
+			}},
+			columns: []string{"Sum Uptime", "Avg Uptime", "Sum Memory", "Max Memory"},
+			rows:    [][]string{{"4h", "80m", "3840Mi", "2Gi"}},
+		},
+		{
+			name: "namespace and JSONPath keys",
+			t:    workers,
+			g: GroupBy{Keys: []string{"Namespace", "{.metadata.name}"}, Aggregations: []Aggregation{
+				{Func: AggregateCount},
+			}},
+			columns: []string{"Namespace", "{.metadata.name}", "Count"},
+			rows: [][]string{
+				{"ci", "w1", "1"},
+				{"ci", "w2", "1"},
+				{"batch", "w3", "1"},
+				{"batch", "w4", "1"},
+			},
+		},
+		{
+			name:    "summary of no rows",
+			t:       Table{GVK: workers.GVK, Columns: workers.Columns},
+			g:       GroupBy{Aggregations: []Aggregation{{Func: AggregateCount}, {Func: AggregateSum, Column: "Jobs"}, {Func: AggregateAvg, Column: "Load"}}},
+			columns: []string{"Count", "Sum Jobs", "Avg Load"},
+			rows:    [][]string{{"0", "0", "<none>"}},
+		},
+		{
+			name: "sum of text",
+			t:    workers,
+			g:    GroupBy{Aggregations: []Aggregation{{Func: AggregateSum, Column: "State"}}},
+			err:  `cannot sum State: "Idle" is not a number, duration or quantity`,
+		},
+		{
+			name: "sum of numbers and durations",
+			t: Table{GVK: workers.GVK, Columns: workers.Columns, Rows: []Row{
+				{Cells: map[string]interface{}{"Uptime": int64(60)}},
+				{Cells: map[string]interface{}{"Uptime": uptime(time.Minute)}},
+			}},
+			g:   GroupBy{Aggregations: []Aggregation{{Func: AggregateAvg, Column: "Uptime"}}},
+			err: "cannot avg Uptime: it mixes numbers, durations and quantities",
+		},
+		{
+			name: "unknown column",
+			t:    workers,
+			g:    GroupBy{Keys: []string{"Region"}},
+			err:  `column "Region" not found`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GroupRows(tt.t, tt.g)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.GVK.Kind != "WorkerSummary" {
+				t.Errorf("got kind %q, want WorkerSummary", got.GVK.Kind)
+			}
+			var columns []string
+			for _, col := range got.Columns {
+				columns = append(columns, col.Name)
+			}
+			if !reflect.DeepEqual(columns, tt.columns) {
+				t.Errorf("got columns %v, want %v", columns, tt.columns)
+			}
+			var rows [][]string
+			for _, row := range got.Rows {
+				var line []string
+				for _, col := range got.Columns {
+					line = append(line, DisplayValue(row.Cells[col.Name]))
+				}
+				rows = append(rows, line)
+				if row.Object != nil || row.Health.Level != HealthUnknown {
+					t.Errorf("derived row has object %v and health %v", row.Object, row.Health)
+				}
+			}
+			if !reflect.DeepEqual(rows, tt.rows) {
+				t.Errorf("got rows %v, want %v", rows, tt.rows)
+			}
+		})
+	}
+}
+
+func TestParseAggregations(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []Aggregation
+		err  string
+	}{
+		{
+			spec: "count,sum(Restarts),MOST:max(Restarts)",
+			want: []Aggregation{
+				{Func: AggregateCount},
+				{Func: AggregateSum, Column: "Restarts"},
+				{Header: "MOST", Func: AggregateMax, Column: "Restarts"},
+			},
+		},
+		{
+			spec: "AVG(.status.containerStatuses[0].restartCount)",
+			want: []Aggregation{{Func: AggregateAvg, Column: ".status.containerStatuses[0].restartCount"}},
+		},
+		{spec: "sum", err: "expected sum(<column>)"},
+		{spec: "median(Age)", err: `unknown aggregation "median(Age)"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseAggregations(tt.spec)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apiregistration "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+)
+
+func init() {
+	Register(APIServicePrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/staging/src/k8s.io/kube-aggregator/pkg/registry/apiservice/etcd/etcd.go#L74-L116
+
+type APIServicePrinter struct{}
+
+var _ ColumnConverter = APIServicePrinter{}
+var _ HealthConverter = APIServicePrinter{}
+
+func (_ APIServicePrinter) GVK() schema.GroupVersionKind {
+	return apiregistration.SchemeGroupVersion.WithKind("APIService")
+}
+
+func (_ APIServicePrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Service", Type: "string", Description: "The reference to the service that hosts this API endpoint."},
+		{Name: "Available", Type: "string", Description: "Whether this service is available."},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+}
+
+func (p APIServicePrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*apiregistration.APIService)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	service := "Local"
+	if obj.Spec.Service != nil {
+		service = fmt.Sprintf("%s/%s", obj.Spec.Service.Namespace, obj.Spec.Service.Name)
+	}
+	status := string(apiregistration.ConditionUnknown)
+	if condition := getAPIServiceCondition(obj.Status.Conditions, apiregistration.Available); condition != nil {
+		switch {
+		case condition.Status == apiregistration.ConditionTrue:
+			status = string(condition.Status)
+		case len(condition.Reason) > 0:
+			status = fmt.Sprintf("%s (%s)", condition.Status, condition.Reason)
+		default:
+			status = string(condition.Status)
+		}
+	}
+
+	row["Name"] = obj.Name
+	row["Service"] = service
+	row["Available"] = status
+	row["Age"] = timestampCell(obj.CreationTimestamp)
+	return row, nil
+}
+
+func (_ APIServicePrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*apiregistration.APIService)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return apiServiceHealth(obj)
+}
+
+func apiServiceHealth(obj *apiregistration.APIService) Health {
+	condition := getAPIServiceCondition(obj.Status.Conditions, apiregistration.Available)
+	switch {
+	case condition == nil || condition.Status == apiregistration.ConditionUnknown:
+		return Health{Level: HealthUnknown}
+	case condition.Status == apiregistration.ConditionTrue:
+		return Health{Level: HealthOK, Reason: condition.Reason}
+	}
+	return Health{Level: HealthError, Reason: condition.Reason}
+}
+
+func getAPIServiceCondition(conditions []apiregistration.APIServiceCondition, conditionType apiregistration.APIServiceConditionType) *apiregistration.APIServiceCondition {
+	for i, condition := range conditions {
+		if condition.Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Cell is a converted value whose display text differs from the data it is
+// computed from, e.g. an Age of "3h" computed from the creation timestamp or
+// "<none>" for a missing value. Raw is one of time.Time, time.Duration,
+// int64, bool, string, []string, Ratio, resource.Quantity or nil.
+type Cell struct {
+	Raw     interface{}
+	Display string
+
+	// since is the time from which the value grows, e.g. a Job's start
+	// time; values that differ only by elapsed time compare equal.
+	since time.Time
+}
+
+func (c Cell) String() string {
+	return c.Display
+}
+
+func (c Cell) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Raw     interface{} `json:"raw"`
+		Display string      `json:"display"`
+	}{c.Raw, c.Display})
+}
+
+// RawValue returns the data a cell value was computed from.
+func RawValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case Cell:
+		return v.Raw
+	case PodRestarts:
+		return v.Count
+	}
+	return v
+}
+
+// sameValue reports whether two cell values hold the same data. Values that
+// grow with the current time are the same if they are counted from the same
+// time, so converting an unchanged object twice gives the same values.
+func sameValue(a, b interface{}) bool {
+	ca, okA := a.(Cell)
+	cb, okB := b.(Cell)
+	if okA && okB && !ca.since.IsZero() && !cb.since.IsZero() {
+		return ca.since.Equal(cb.since)
+	}
+	return reflect.DeepEqual(RawValue(a), RawValue(b))
+}
+
+// DisplayValue returns the text a cell value is printed as.
+func DisplayValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "<none>"
+	case string:
+		return v
+	case resource.Quantity:
+		// String has a pointer receiver
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// timestampCell returns a cell that prints the time elapsed since t.
+func timestampCell(t metav1.Time) Cell {
+	if t.IsZero() {
+		return Cell{Display: translateTimestampSince(t)}
+	}
+	return Cell{Raw: t.Time, Display: translateTimestampSince(t)}
+}
+
+// optionalCell returns a cell that prints placeholder, e.g. "<none>", for
+// an empty value.
+func optionalCell(value, placeholder string) Cell {
+	if value == "" {
+		return Cell{Display: placeholder}
+	}
+	return Cell{Raw: value, Display: value}
+}
+
+// listCell returns a cell that prints values separated by ",", or
+// placeholder if there are none.
+func listCell(values []string, placeholder string) Cell {
+	if len(values) == 0 {
+		return Cell{Display: placeholder}
+	}
+	return Cell{Raw: values, Display: strings.Join(values, ",")}
+}
+
+func boolPtrCell(value *bool) Cell {
+	if value == nil {
+		return Cell{Display: printBoolPtr(value)}
+	}
+	return Cell{Raw: *value, Display: printBoolPtr(value)}
+}
+
+// Ratio is the raw value of cells like a Ready of "1/3". It is written to
+// JSON as its text.
+type Ratio struct {
+	Num   int64
+	Denom int64
+}
+
+func (r Ratio) String() string {
+	return fmt.Sprintf("%d/%d", r.Num, r.Denom)
+}
+
+func (r Ratio) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func ratioCell(num, denom int64) Cell {
+	r := Ratio{Num: num, Denom: denom}
+	return Cell{Raw: r, Display: r.String()}
+}
+
+// quantityCell returns a cell that prints q in its canonical form, e.g.
+// "500m" or "128Mi", and keeps q as raw value.
+func quantityCell(q *resource.Quantity) Cell {
+	return Cell{Raw: q.DeepCopy(), Display: q.String()}
+}
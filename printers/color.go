@@ -0,0 +1,120 @@
+package printers
+
+import (
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+	core "k8s.io/api/core/v1"
+)
+
+// ColorMode controls whether writers highlight cells with ANSI colors.
+type ColorMode int
+
+const (
+	// ColorAuto colors output written to a terminal, unless the NO_COLOR
+	// environment variable is set or TERM is "dumb".
+	ColorAuto ColorMode = iota
+	ColorAlways
+	ColorNever
+)
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiBold   = "\x1b[1m"
+)
+
+// healthColumns are colored by the Health of their row.
+var healthColumns = map[string]bool{
+	"Status":      true,
+	"Available":   true,
+	"Completions": true,
+}
+
+var ratioRegex = regexp.MustCompile(`^(\d+)/(\d+)$`)
+
+func (m ColorMode) enabled(out io.Writer) bool {
+	switch m {
+	case ColorAlways:
+		return true
+	case ColorNever:
+		return false
+	}
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isTerminal(out)
+}
+
+func isTerminal(out io.Writer) bool {
+	f, ok := out.(*os.File)
+	if !ok {
+		return false
+	}
+	return term.IsTerminal(int(f.Fd()))
+}
+
+func healthColor(level HealthLevel) string {
+	switch level {
+	case HealthOK:
+		return ansiGreen
+	case HealthProgressing:
+		return ansiYellow
+	case HealthWarning:
+		return ansiBold + ansiYellow
+	case HealthError:
+		return ansiRed
+	}
+	return ""
+}
+
+// cellColor returns the ANSI color of a cell, or "" if it is not highlighted.
+func cellColor(column string, value interface{}, health Health) string {
+	return healthColor(cellHighlight(column, value, health))
+}
+
+// cellHighlight returns the level a cell is highlighted with, or "" if it
+// is not highlighted. Ready cells like "1/3" are highlighted when they are
+// not full, Last Event cells when the event is a warning, cells of snapshot
+// diffs by their change and status cells by the health of their row.
+func cellHighlight(column string, value interface{}, health Health) HealthLevel {
+	if _, ok := value.(CellChange); ok {
+		return HealthProgressing
+	}
+	if change, ok := value.(Change); ok && column == changeColumn {
+		return changeHighlight(change)
+	}
+	if column == "Ready" {
+		if m := ratioRegex.FindStringSubmatch(DisplayValue(value)); m != nil {
+			ready, _ := strconv.Atoi(m[1])
+			total, _ := strconv.Atoi(m[2])
+			switch {
+			case ready >= total:
+				return ""
+			case ready == 0:
+				return HealthError
+			}
+			return HealthProgressing
+		}
+	}
+	if column == lastEventColumn && strings.HasPrefix(matchText(value), core.EventTypeWarning+" ") {
+		return HealthWarning
+	}
+	if healthColumns[column] {
+		return health.Level
+	}
+	return ""
+}
+
+func colorize(s, color string) string {
+	if color == "" {
+		return s
+	}
+	return color + s + ansiReset
+}
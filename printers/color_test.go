@@ -0,0 +1,40 @@
+package printers
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestColorModeEnabled(t *testing.T) {
+	devNull, err := os.Open(os.DevNull)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer devNull.Close()
+
+	tests := []struct {
+		name    string
+		mode    ColorMode
+		out     io.Writer
+		noColor string
+		want    bool
+	}{
+		{name: "always to a buffer", mode: ColorAlways, out: &bytes.Buffer{}, want: true},
+		{name: "always despite NO_COLOR", mode: ColorAlways, out: devNull, noColor: "1", want: true},
+		{name: "never", mode: ColorNever, out: devNull, want: false},
+		{name: "auto to a buffer", mode: ColorAuto, out: &bytes.Buffer{}, want: false},
+		// /dev/null is a character device but not a terminal.
+		{name: "auto to /dev/null", mode: ColorAuto, out: devNull, want: false},
+		{name: "auto with NO_COLOR", mode: ColorAuto, out: os.Stdout, noColor: "1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tt.noColor)
+			if got := tt.mode.enabled(tt.out); got != tt.want {
+				t.Errorf("enabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
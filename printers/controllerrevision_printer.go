@@ -0,0 +1,59 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(ControllerRevisionPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L449-L454
+
+type ControllerRevisionPrinter struct{}
+
+var _ ColumnConverter = ControllerRevisionPrinter{}
+
+func (_ ControllerRevisionPrinter) GVK() schema.GroupVersionKind {
+	return apps.SchemeGroupVersion.WithKind("ControllerRevision")
+}
+
+func (_ ControllerRevisionPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Controller", Type: "string", Description: "Controller of the object"},
+		{Name: "Revision", Type: "string", Description: apps.ControllerRevision{}.SwaggerDoc()["revision"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+}
+
+func (p ControllerRevisionPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*apps.ControllerRevision)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	controllerName := ""
+	if controllerRef := metav1.GetControllerOf(obj); controllerRef != nil {
+		gv, err := schema.ParseGroupVersion(controllerRef.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		controllerName = formatResourceName(gv.WithKind(controllerRef.Kind).GroupKind(), controllerRef.Name, true)
+	}
+
+	row["Name"] = obj.Name
+	row["Controller"] = optionalCell(controllerName, "<none>")
+	row["Revision"] = obj.Revision
+	row["Age"] = timestampCell(obj.CreationTimestamp)
+
+	return row, nil
+}
@@ -0,0 +1,66 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(CustomResourceDefinitionPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/staging/src/k8s.io/apiserver/pkg/registry/rest/table.go#L44-L89
+
+type CustomResourceDefinitionPrinter struct{}
+
+var _ ColumnConverter = CustomResourceDefinitionPrinter{}
+
+func (_ CustomResourceDefinitionPrinter) GVK() schema.GroupVersionKind {
+	return crdv1.SchemeGroupVersion.WithKind("CustomResourceDefinition")
+}
+
+func (_ CustomResourceDefinitionPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Created At", Type: "date", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Group", Type: "string", Priority: 1, Description: "The API group of the defined custom resource."},
+		{Name: "Scope", Type: "string", Priority: 1, Description: "Whether the custom resource is cluster- or namespace-scoped."},
+		{Name: "Served Versions", Type: "string", Priority: 1, Description: "Versions of the custom resource served via REST APIs."},
+		{Name: "Storage Version", Type: "string", Priority: 1, Description: "Version of the custom resource used when persisting to storage."},
+	}
+}
+
+func (p CustomResourceDefinitionPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*crdv1.CustomResourceDefinition)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	row["Name"] = obj.Name
+	row["Created At"] = Cell{Raw: obj.CreationTimestamp.Time, Display: obj.CreationTimestamp.UTC().Format(time.RFC3339)}
+
+	served := []string{}
+	storage := ""
+	for _, v := range obj.Spec.Versions {
+		if v.Served {
+			served = append(served, v.Name)
+		}
+		if v.Storage {
+			storage = v.Name
+		}
+	}
+	row["Group"] = obj.Spec.Group
+	row["Scope"] = string(obj.Spec.Scope)
+	row["Served Versions"] = listCell(served, "<none>")
+	row["Storage Version"] = optionalCell(storage, "<none>")
+
+	return row, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	batchv1 "k8s.io/api/batch/v1"
 	batch "k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -19,11 +20,26 @@ func init() {
 type CronJobPrinter struct{}
 
 var _ ColumnConverter = CronJobPrinter{}
+var _ HealthConverter = CronJobPrinter{}
 
 func (_ CronJobPrinter) GVK() schema.GroupVersionKind {
 	return batch.SchemeGroupVersion.WithKind("CronJob")
 }
 
+func (_ CronJobPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Schedule", Type: "string", Description: batch.CronJobSpec{}.SwaggerDoc()["schedule"]},
+		{Name: "Suspend", Type: "boolean", Description: batch.CronJobSpec{}.SwaggerDoc()["suspend"]},
+		{Name: "Active", Type: "integer", Description: batch.CronJobStatus{}.SwaggerDoc()["active"]},
+		{Name: "Last Schedule", Type: "string", Description: batch.CronJobStatus{}.SwaggerDoc()["lastScheduleTime"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+		{Name: "Selector", Type: "string", Priority: 1, Description: batchv1.JobSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
 func (p CronJobPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*batch.CronJob)
 	if !ok {
@@ -32,17 +48,17 @@ func (p CronJobPrinter) Convert(o runtime.Object) (map[string]interface{}, error
 
 	row := map[string]interface{}{}
 
-	lastScheduleTime := "<none>"
+	lastScheduleTime := Cell{Display: "<none>"}
 	if obj.Status.LastScheduleTime != nil {
-		lastScheduleTime = translateTimestampSince(*obj.Status.LastScheduleTime)
+		lastScheduleTime = timestampCell(*obj.Status.LastScheduleTime)
 	}
 
 	row["Name"] = obj.Name
 	row["Schedule"] = obj.Spec.Schedule
-	row["Suspend"] = printBoolPtr(obj.Spec.Suspend)
+	row["Suspend"] = boolPtrCell(obj.Spec.Suspend)
 	row["Active"] = int64(len(obj.Status.Active))
 	row["Last Schedule"] = lastScheduleTime
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	names, images := layoutContainerCells(obj.Spec.JobTemplate.Spec.Template.Spec.Containers)
 	row["Containers"] = names
@@ -51,3 +67,14 @@ func (p CronJobPrinter) Convert(o runtime.Object) (map[string]interface{}, error
 
 	return row, nil
 }
+
+func (_ CronJobPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*batch.CronJob)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	if obj.Spec.Suspend != nil && *obj.Spec.Suspend {
+		return Health{Level: HealthWarning, Reason: "Suspended"}
+	}
+	return Health{Level: HealthOK}
+}
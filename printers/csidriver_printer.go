@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	storage "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -24,6 +25,19 @@ func (_ CSIDriverPrinter) GVK() schema.GroupVersionKind {
 	return storage.SchemeGroupVersion.WithKind("CSIDriver")
 }
 
+func (_ CSIDriverPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "AttachRequired", Type: "boolean", Description: storage.CSIDriverSpec{}.SwaggerDoc()["attachRequired"]},
+		{Name: "PodInfoOnMount", Type: "boolean", Description: storage.CSIDriverSpec{}.SwaggerDoc()["podInfoOnMount"]},
+		{Name: "StorageCapacity", Type: "boolean", Description: storage.CSIDriverSpec{}.SwaggerDoc()["storageCapacity"]},
+		{Name: "TokenRequests", Type: "string", Description: storage.CSIDriverSpec{}.SwaggerDoc()["tokenRequests"]},
+		{Name: "RequiresRepublish", Type: "boolean", Description: storage.CSIDriverSpec{}.SwaggerDoc()["requiresRepublish"]},
+		{Name: "Modes", Type: "string", Description: storage.CSIDriverSpec{}.SwaggerDoc()["volumeLifecycleModes"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+}
+
 func (p CSIDriverPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*storage.CSIDriver)
 	if !ok {
@@ -44,10 +58,7 @@ func (p CSIDriverPrinter) Convert(o runtime.Object) (map[string]interface{}, err
 	for _, mode := range obj.Spec.VolumeLifecycleModes {
 		allModes = append(allModes, string(mode))
 	}
-	modes := strings.Join(allModes, ",")
-	if len(modes) == 0 {
-		modes = "<none>"
-	}
+	modes := listCell(allModes, "<none>")
 
 	row["Name"] = obj.Name
 	row["AttachRequired"] = attachRequired
@@ -59,13 +70,13 @@ func (p CSIDriverPrinter) Convert(o runtime.Object) (map[string]interface{}, err
 	}
 	row["StorageCapacity"] = storageCapacity
 
-	tokenRequests := "<unset>"
+	tokenRequests := Cell{Display: "<unset>"}
 	if obj.Spec.TokenRequests != nil {
 		audiences := []string{}
 		for _, t := range obj.Spec.TokenRequests {
 			audiences = append(audiences, t.Audience)
 		}
-		tokenRequests = strings.Join(audiences, ",")
+		tokenRequests = Cell{Raw: audiences, Display: strings.Join(audiences, ",")}
 	}
 	requiresRepublish := false
 	if obj.Spec.RequiresRepublish != nil {
@@ -75,7 +86,7 @@ func (p CSIDriverPrinter) Convert(o runtime.Object) (map[string]interface{}, err
 	row["TokenRequests"] = tokenRequests
 	row["RequiresRepublish"] = requiresRepublish
 	row["Modes"] = modes
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	return row, nil
 }
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	storage "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -23,6 +24,14 @@ func (_ CSINodePrinter) GVK() schema.GroupVersionKind {
 	return storage.SchemeGroupVersion.WithKind("CSINode")
 }
 
+func (_ CSINodePrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Drivers", Type: "integer", Description: "Drivers indicates the number of CSI drivers registered on the node"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+}
+
 func (p CSINodePrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*storage.CSINode)
 	if !ok {
@@ -33,7 +42,7 @@ func (p CSINodePrinter) Convert(o runtime.Object) (map[string]interface{}, error
 
 	row["Name"] = obj.Name
 	row["Drivers"] = len(obj.Spec.Drivers)
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	return row, nil
 }
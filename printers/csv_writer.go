@@ -0,0 +1,111 @@
+package printers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// CSVWriter writes tables as RFC 4180 CSV, with a header row in column
+// definition order. Tables of more than one kind are written as a single
+// table with the union of their columns, in the order they are first seen,
+// and a Kind column; cells of columns that a kind does not have are empty.
+type CSVWriter struct {
+	// Comma is the field delimiter. It defaults to ',', use '\t' for TSV.
+	Comma     rune
+	NoHeaders bool
+	// Raw writes the raw values of cells instead of their display text.
+	Raw bool
+	// WithKind and WithNamespace add Kind and Namespace columns in front of
+	// the converter columns. The Kind column is always added for more than
+	// one kind.
+	WithKind      bool
+	WithNamespace bool
+}
+
+func (w CSVWriter) Write(out io.Writer, tables ...Table) error {
+	cw := csv.NewWriter(out)
+	if w.Comma != 0 {
+		cw.Comma = w.Comma
+	}
+
+	withKind := w.WithKind
+	var columns []string
+	seen := map[string]bool{}
+	for _, t := range tables {
+		if t.GVK != tables[0].GVK {
+			withKind = true
+		}
+		for _, col := range t.Columns {
+			if !seen[col.Name] {
+				seen[col.Name] = true
+				columns = append(columns, col.Name)
+			}
+		}
+	}
+
+	if !w.NoHeaders {
+		var header []string
+		if withKind {
+			header = append(header, "Kind")
+		}
+		if w.WithNamespace {
+			header = append(header, "Namespace")
+		}
+		header = append(header, columns...)
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range tables {
+		has := make(map[string]bool, len(t.Columns))
+		for _, col := range t.Columns {
+			has[col.Name] = true
+		}
+		for _, row := range t.Rows {
+			var record []string
+			if withKind {
+				record = append(record, t.GVK.Kind)
+			}
+			if w.WithNamespace {
+				ns, _ := row.namespaceName()
+				record = append(record, ns)
+			}
+			for _, name := range columns {
+				v := row.Cells[name]
+				switch {
+				case !has[name]:
+					record = append(record, "")
+				case w.Raw:
+					record = append(record, rawText(RawValue(v)))
+				default:
+					record = append(record, DisplayValue(v))
+				}
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// rawText formats a raw cell value for machine consumption: times as
+// RFC 3339, durations in seconds and lists separated by ",".
+func rawText(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case time.Duration:
+		return fmt.Sprintf("%d", int64(v.Seconds()))
+	case []string:
+		return strings.Join(v, ",")
+	}
+	return DisplayValue(v)
+}
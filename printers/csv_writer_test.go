@@ -0,0 +1,88 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestCSVWriter(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	pods := Table{
+		GVK: schema.GroupVersionKind{Version: "v1", Kind: "Pod"},
+		Columns: []metav1.TableColumnDefinition{
+			{Name: "Name"}, {Name: "Status"}, {Name: "Age"},
+		},
+		Rows: []Row{
+			{Cells: map[string]interface{}{"Name": "a", "Status": `Error: "OOM", exit 137`, "Age": Cell{Raw: created, Display: "18d"}}},
+			{Cells: map[string]interface{}{"Name": "b", "Status": "line1\nline2", "Age": Cell{Display: "<unknown>"}}},
+		},
+	}
+	services := Table{
+		GVK: schema.GroupVersionKind{Version: "v1", Kind: "Service"},
+		Columns: []metav1.TableColumnDefinition{
+			{Name: "Name"}, {Name: "Type"}, {Name: "Age"},
+		},
+		Rows: []Row{
+			{Cells: map[string]interface{}{"Name": "s", "Type": "ClusterIP", "Age": Cell{Raw: created, Display: "18d"}}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		w      CSVWriter
+		tables []Table
+		want   string
+	}{
+		{
+			name:   "quotes, commas and newlines",
+			tables: []Table{pods},
+			want: "Name,Status,Age\n" +
+				"a,\"Error: \"\"OOM\"\", exit 137\",18d\n" +
+				"b,\"line1\nline2\",<unknown>\n",
+		},
+		{
+			name:   "raw values",
+			w:      CSVWriter{Raw: true, NoHeaders: true},
+			tables: []Table{pods},
+			want: "a,\"Error: \"\"OOM\"\", exit 137\",2026-10-01T12:00:00Z\n" +
+				"b,\"line1\nline2\",\n",
+		},
+		{
+			name:   "tsv",
+			w:      CSVWriter{Comma: '\t', WithKind: true},
+			tables: []Table{services},
+			want:   "Kind\tName\tType\tAge\nService\ts\tClusterIP\t18d\n",
+		},
+		{
+			name:   "union of the columns of several kinds",
+			tables: []Table{pods, services},
+			want: "Kind,Name,Status,Age,Type\n" +
+				"Pod,a,\"Error: \"\"OOM\"\", exit 137\",18d,\n" +
+				"Pod,b,\"line1\nline2\",<unknown>,\n" +
+				"Service,s,,18d,ClusterIP\n",
+		},
+		{
+			name:   "several kinds without headers",
+			w:      CSVWriter{NoHeaders: true},
+			tables: []Table{services, pods},
+			want: "Service,s,ClusterIP,18d,\n" +
+				"Pod,a,,18d,\"Error: \"\"OOM\"\", exit 137\"\n" +
+				"Pod,b,,<unknown>,\"line1\nline2\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.w.Write(&buf, tt.tables...); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package printers
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CustomColumn selects a column of a Table. Spec is either the name of a
+// converter column, e.g. Images, or a JSONPath expression evaluated against
+// the source object, e.g. .spec.nodeName or {.metadata.labels.app}.
+type CustomColumn struct {
+	Header string
+	Spec   string
+}
+
+// ParseCustomColumns parses a kubectl-style custom-columns specification,
+// e.g. "NAME:Name,IMG:Images,NODE:.spec.nodeName". The header may be left
+// out for converter columns, e.g. "Name,Images". Headers must be unique,
+// ignoring case.
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if len(spec) == 0 {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	parts := strings.Split(spec, ",")
+	columns := make([]CustomColumn, len(parts))
+	// cells are keyed by header, so two columns with the same header would
+	// show the same values
+	headers := map[string]bool{}
+	for i, part := range parts {
+		header, colSpec := part, part
+		if idx := strings.Index(part, ":"); idx >= 0 {
+			header, colSpec = part[:idx], part[idx+1:]
+		} else if isJSONPath(part) {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", part)
+		}
+		if len(header) == 0 || len(colSpec) == 0 {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<column>", part)
+		}
+		if headers[strings.ToUpper(header)] {
+			return nil, fmt.Errorf("duplicate custom-columns header %q", header)
+		}
+		headers[strings.ToUpper(header)] = true
+		columns[i] = CustomColumn{Header: header, Spec: colSpec}
+	}
+	return columns, nil
+}
+
+// SelectColumns returns a copy of t with the given columns, in the given
+// order. Converter columns must be present in t, so build t with
+// PriorityExtended to select from every column of a kind.
+func SelectColumns(t Table, columns []CustomColumn) (Table, error) {
+	result := Table{
+		GVK:     t.GVK,
+		Columns: make([]metav1.TableColumnDefinition, len(columns)),
+		Rows:    make([]Row, len(t.Rows)),
+	}
+	for i := range t.Rows {
+		result.Rows[i] = Row{
+			Object: t.Rows[i].Object,
+			Cells:  map[string]interface{}{},
+			Health: t.Rows[i].Health,
+		}
+	}
+
+	for i, col := range columns {
+		if isJSONPath(col.Spec) {
+			jp, err := parseJSONPath(col.Spec)
+			if err != nil {
+				return Table{}, err
+			}
+			result.Columns[i] = metav1.TableColumnDefinition{
+				Name:        col.Header,
+				Type:        "string",
+				Description: fmt.Sprintf("Custom column %s", col.Spec),
+			}
+			for j, row := range t.Rows {
+				v, err := evalJSONPath(jp, row.Object)
+				if err != nil {
+					return Table{}, err
+				}
+				result.Rows[j].Cells[col.Header] = v
+			}
+			continue
+		}
+
+		def, ok := findColumn(t.Columns, col.Spec)
+		if !ok {
+			return Table{}, fmt.Errorf("column %q not found for %v", col.Spec, t.GVK)
+		}
+		name := def.Name
+		def.Name = col.Header
+		result.Columns[i] = def
+		for j, row := range t.Rows {
+			result.Rows[j].Cells[col.Header] = row.Cells[name]
+		}
+	}
+	return result, nil
+}
+
+func findColumn(columns []metav1.TableColumnDefinition, name string) (metav1.TableColumnDefinition, bool) {
+	for _, col := range columns {
+		if strings.EqualFold(col.Name, name) {
+			return col, true
+		}
+	}
+	return metav1.TableColumnDefinition{}, false
+}
@@ -0,0 +1,50 @@
+package printers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseCustomColumns(t *testing.T) {
+	tests := []struct {
+		spec string
+		want []CustomColumn
+		err  string
+	}{
+		{
+			spec: "NAME:Name,IMG:Images,NODE:.spec.nodeName",
+			want: []CustomColumn{{"NAME", "Name"}, {"IMG", "Images"}, {"NODE", ".spec.nodeName"}},
+		},
+		{
+			spec: "Name,Restarts",
+			want: []CustomColumn{{"Name", "Name"}, {"Restarts", "Restarts"}},
+		},
+		{
+			spec: "APP:{.metadata.labels.app},TIER:{.metadata.labels.tier}",
+			want: []CustomColumn{{"APP", "{.metadata.labels.app}"}, {"TIER", "{.metadata.labels.tier}"}},
+		},
+		{spec: "", err: "no custom columns given"},
+		{spec: "A:.x,A:.y", err: `duplicate custom-columns header "A"`},
+		{spec: "Node,node:.spec.nodeName", err: `duplicate custom-columns header "node"`},
+		{spec: "NAME:Name,.metadata.uid", err: "expected <header>:<json-path-expr>"},
+		{spec: "IP:", err: "expected <header>:<column>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseCustomColumns(tt.spec)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
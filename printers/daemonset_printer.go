@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -20,11 +21,28 @@ func init() {
 type DaemonSetPrinter struct{}
 
 var _ ColumnConverter = DaemonSetPrinter{}
+var _ HealthConverter = DaemonSetPrinter{}
 
 func (_ DaemonSetPrinter) GVK() schema.GroupVersionKind {
 	return apps.SchemeGroupVersion.WithKind("DaemonSet")
 }
 
+func (_ DaemonSetPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Desired", Type: "integer", Description: apps.DaemonSetStatus{}.SwaggerDoc()["desiredNumberScheduled"]},
+		{Name: "Current", Type: "integer", Description: apps.DaemonSetStatus{}.SwaggerDoc()["currentNumberScheduled"]},
+		{Name: "Ready", Type: "integer", Description: apps.DaemonSetStatus{}.SwaggerDoc()["numberReady"]},
+		{Name: "Up-to-date", Type: "integer", Description: apps.DaemonSetStatus{}.SwaggerDoc()["updatedNumberScheduled"]},
+		{Name: "Available", Type: "integer", Description: apps.DaemonSetStatus{}.SwaggerDoc()["numberAvailable"]},
+		{Name: "Node Selector", Type: "string", Description: core.PodSpec{}.SwaggerDoc()["nodeSelector"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+		{Name: "Selector", Type: "string", Priority: 1, Description: apps.DaemonSetSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
 func (p DaemonSetPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*apps.DaemonSet)
 	if !ok {
@@ -46,7 +64,7 @@ func (p DaemonSetPrinter) Convert(o runtime.Object) (map[string]interface{}, err
 	row["Up-to-date"] = int64(numberUpdated)
 	row["Available"] = int64(numberAvailable)
 	row["Node Selector"] = labels.FormatLabels(obj.Spec.Template.Spec.NodeSelector)
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	names, images := layoutContainerCells(obj.Spec.Template.Spec.Containers)
 	row["Containers"] = names
@@ -55,3 +73,14 @@ func (p DaemonSetPrinter) Convert(o runtime.Object) (map[string]interface{}, err
 
 	return row, nil
 }
+
+func (_ DaemonSetPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*apps.DaemonSet)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	if obj.Status.UpdatedNumberScheduled < obj.Status.DesiredNumberScheduled && obj.Status.NumberReady >= obj.Status.DesiredNumberScheduled {
+		return Health{Level: HealthProgressing, Reason: "RollingUpdate"}
+	}
+	return replicasHealth(int64(obj.Status.DesiredNumberScheduled), int64(obj.Status.NumberReady))
+}
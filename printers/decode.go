@@ -0,0 +1,150 @@
+package printers
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1beta1 "k8s.io/api/networking/v1beta1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/watch"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// Scheme knows the types of every kind with a registered ColumnConverter.
+var Scheme = runtime.NewScheme()
+
+var decoder runtime.Decoder
+
+func init() {
+	utilruntime.Must(corev1.AddToScheme(Scheme))
+	utilruntime.Must(appsv1.AddToScheme(Scheme))
+	utilruntime.Must(autoscalingv1.AddToScheme(Scheme))
+	utilruntime.Must(batchv1.AddToScheme(Scheme))
+	utilruntime.Must(batchv1beta1.AddToScheme(Scheme))
+	utilruntime.Must(networkingv1beta1.AddToScheme(Scheme))
+	utilruntime.Must(storagev1.AddToScheme(Scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(Scheme))
+	utilruntime.Must(apiregistrationv1.AddToScheme(Scheme))
+	utilruntime.Must(metricsv1beta1.AddToScheme(Scheme))
+	metav1.AddMetaToScheme(Scheme)
+
+	decoder = serializer.NewCodecFactory(Scheme).UniversalDeserializer()
+}
+
+// DecodeObjects reads a stream of JSON or YAML documents. Lists are
+// flattened into their items. Objects of kinds that Scheme does not know,
+// e.g. custom resources, are decoded as *unstructured.Unstructured; see
+// HasConverter.
+func DecodeObjects(r io.Reader) ([]runtime.Object, error) {
+	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	var objs []runtime.Object
+	for {
+		var raw runtime.RawExtension
+		if err := d.Decode(&raw); err == io.EOF {
+			return objs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		raw.Raw = bytes.TrimSpace(raw.Raw)
+		if len(raw.Raw) == 0 || bytes.Equal(raw.Raw, []byte("null")) {
+			continue
+		}
+
+		items, err := decodeObject(raw.Raw)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, items...)
+	}
+}
+
+// decode decodes a JSON object into its type in Scheme, or into an
+// unstructured object if Scheme does not know its kind.
+func decode(data []byte) (runtime.Object, error) {
+	obj, _, err := decoder.Decode(data, nil, nil)
+	if runtime.IsNotRegisteredError(err) {
+		obj, _, err = unstructured.UnstructuredJSONScheme.Decode(data, nil, nil)
+	}
+	return obj, err
+}
+
+func decodeObject(data []byte) ([]runtime.Object, error) {
+	obj, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	if !meta.IsListType(obj) {
+		return []runtime.Object{obj}, nil
+	}
+
+	if list, ok := obj.(*corev1.List); ok {
+		var objs []runtime.Object
+		for _, item := range list.Items {
+			items, err := decodeObject(item.Raw)
+			if err != nil {
+				return nil, err
+			}
+			objs = append(objs, items...)
+		}
+		return objs, nil
+	}
+
+	// typed lists usually leave the type of their items empty
+	itemGVK := obj.GetObjectKind().GroupVersionKind()
+	itemGVK.Kind = strings.TrimSuffix(itemGVK.Kind, "List")
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if item.GetObjectKind().GroupVersionKind().Empty() {
+			item.GetObjectKind().SetGroupVersionKind(itemGVK)
+		}
+	}
+	return items, nil
+}
+
+// DecodeWatchEvents reads newline-delimited JSON watch events, as returned
+// by the watch API, and calls fn for each of them. Objects are decoded like
+// by DecodeObjects.
+func DecodeWatchEvents(r io.Reader, fn func(watch.Event) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var e metav1.WatchEvent
+		if err := json.Unmarshal(line, &e); err != nil {
+			return fmt.Errorf("invalid watch event: %w", err)
+		}
+		obj, err := decode(e.Object.Raw)
+		if err != nil {
+			return err
+		}
+		if err := fn(watch.Event{Type: watch.EventType(e.Type), Object: obj}); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,189 @@
+package printers
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// decodeFile decodes the objects of a file in testdata.
+func decodeFile(t *testing.T, name string) []runtime.Object {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	objs, err := DecodeObjects(f)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return objs
+}
+
+// objectNames returns "Kind/name" for each of objs.
+func objectNames(t *testing.T, objs []runtime.Object) []string {
+	t.Helper()
+	names := make([]string, len(objs))
+	for i, o := range objs {
+		m, err := meta.Accessor(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names[i] = o.GetObjectKind().GroupVersionKind().Kind + "/" + m.GetName()
+	}
+	return names
+}
+
+func TestDecodeObjects(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+		err   string
+	}{
+		{
+			name: "yaml documents",
+			input: `apiVersion: v1
+kind: Pod
+metadata: {name: a}
+---
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: b}
+`,
+			want: []string{"Pod/a", "Deployment/b"},
+		},
+		{
+			name:  "json list",
+			input: `{"apiVersion":"v1","kind":"List","items":[{"apiVersion":"v1","kind":"Pod","metadata":{"name":"a"}},{"apiVersion":"v1","kind":"Service","metadata":{"name":"b"}}]}`,
+			want:  []string{"Pod/a", "Service/b"},
+		},
+		{
+			name:  "typed list without item kinds",
+			input: `{"apiVersion":"v1","kind":"PodList","items":[{"metadata":{"name":"a"}},{"metadata":{"name":"b"}}]}`,
+			want:  []string{"Pod/a", "Pod/b"},
+		},
+		{
+			name:  "unknown kinds",
+			input: `{"apiVersion":"v1","kind":"List","items":[{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"a"}},{"apiVersion":"networking.k8s.io/v1","kind":"Ingress","metadata":{"name":"b"}}]}`,
+			want:  []string{"Widget/a", "Ingress/b"},
+		},
+		{
+			name:  "list of unknown kind",
+			input: `{"apiVersion":"example.com/v1","kind":"WidgetList","items":[{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"a"}}]}`,
+			want:  []string{"Widget/a"},
+		},
+		{
+			name:  "missing kind",
+			input: `{"apiVersion":"v1","metadata":{"name":"a"}}`,
+			err:   "'Kind' is missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objs, err := DecodeObjects(strings.NewReader(tt.input))
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := objectNames(t, objs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeWatchEvents(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "watch.ndjson"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var got []string
+	err = DecodeWatchEvents(f, func(e watch.Event) error {
+		got = append(got, string(e.Type)+" "+e.Object.GetObjectKind().GroupVersionKind().Kind)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"ADDED Pod", "MODIFIED Pod", "BOOKMARK Pod", "DELETED Pod", "ERROR Status"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestDecodeWatchEventsErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{name: "invalid json", input: `{"type":"ADDED",`, err: "invalid watch event"},
+		{name: "missing kind", input: `{"type":"ADDED","object":{"apiVersion":"v1"}}`, err: "'Kind' is missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodeWatchEvents(strings.NewReader(tt.input), func(watch.Event) error { return nil })
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("got error %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestWatchErrorEvent(t *testing.T) {
+	var errs []error
+	f, err := os.Open(filepath.Join("testdata", "watch.ndjson"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	err = DecodeWatchEvents(f, func(e watch.Event) error {
+		if e.Type == watch.Error {
+			errs = append(errs, watchError(e.Object))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "too old resource version") {
+		t.Errorf("got %v, want the message of the Status", errs)
+	}
+}
+
+func TestHasConverter(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{input: `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"a"}}`, want: true},
+		{input: `{"apiVersion":"meta.k8s.io/v1","kind":"PartialObjectMetadata","metadata":{"name":"a"}}`, want: true},
+		{input: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"a"}}`, want: false},
+		{input: `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"a"}}`, want: false},
+	}
+	for _, tt := range tests {
+		objs, err := DecodeObjects(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := HasConverter(objs[0]); got != tt.want {
+			t.Errorf("HasConverter(%s) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	"gomodules.xyz/pointer"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(DeploymentPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L334-L345
+
+type DeploymentPrinter struct{}
+
+var _ ColumnConverter = DeploymentPrinter{}
+var _ HealthConverter = DeploymentPrinter{}
+
+func (_ DeploymentPrinter) GVK() schema.GroupVersionKind {
+	return apps.SchemeGroupVersion.WithKind("Deployment")
+}
+
+func (_ DeploymentPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Ready", Type: "string", Description: "Number of the pod with ready state"},
+		{Name: "Up-to-date", Type: "string", Description: apps.DeploymentStatus{}.SwaggerDoc()["updatedReplicas"]},
+		{Name: "Available", Type: "string", Description: apps.DeploymentStatus{}.SwaggerDoc()["availableReplicas"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+		{Name: "Selector", Type: "string", Priority: 1, Description: apps.DeploymentSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
+func (p DeploymentPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*apps.Deployment)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	desiredReplicas := obj.Spec.Replicas
+	updatedReplicas := obj.Status.UpdatedReplicas
+	readyReplicas := obj.Status.ReadyReplicas
+	availableReplicas := obj.Status.AvailableReplicas
+	selector, err := metav1.LabelSelectorAsSelector(obj.Spec.Selector)
+	if err != nil {
+		// this shouldn't happen if LabelSelector passed validation
+		return nil, err
+	}
+
+	row["Name"] = obj.Name
+	row["Ready"] = ratioCell(int64(readyReplicas), int64(pointer.Int32(desiredReplicas)))
+	row["Up-to-date"] = int64(updatedReplicas)
+	row["Available"] = int64(availableReplicas)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
+
+	names, images := layoutContainerCells(obj.Spec.Template.Spec.Containers)
+	row["Containers"] = names
+	row["Images"] = images
+	row["Selector"] = selector.String()
+
+	return row, nil
+}
+
+func (_ DeploymentPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*apps.Deployment)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return replicasHealth(int64(pointer.Int32(obj.Spec.Replicas)), int64(obj.Status.AvailableReplicas))
+}
@@ -0,0 +1,336 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	apps "k8s.io/api/apps/v1"
+	batch "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// describedColumns are converter columns that Describe shows in sections of
+// their own rather than among the cells of the row.
+var describedColumns = map[string]bool{
+	"Name":          true,
+	"Age":           true,
+	"Controlled By": true,
+	"Containers":    true,
+	"Images":        true,
+}
+
+// DescribeObjects describes objs like kubectl describe, separated by blank
+// lines, each with the events that involve it.
+func DescribeObjects(out io.Writer, objs []runtime.Object, events []core.Event) error {
+	for i, o := range objs {
+		if i > 0 {
+			if _, err := fmt.Fprint(out, "\n\n"); err != nil {
+				return err
+			}
+		}
+		if err := Describe(out, o, events...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Describe writes a kubectl describe-style view of o: its metadata, the
+// cells of its converter row with every column, the containers and volumes
+// of its pod template, its conditions and, if events are given, those that
+// involve o. Values are formatted by the converter of the kind of o, so they
+// read the same as in tables.
+func Describe(out io.Writer, o runtime.Object, events ...core.Event) error {
+	c, err := converterFor(o, o.GetObjectKind().GroupVersionKind())
+	if err != nil {
+		return err
+	}
+	if _, ok := printers[c.GVK()].(PodPrinter); ok {
+		c = extend(PodPrinter{ContainerDetails: true})
+	}
+	row, err := convert(c, o, PriorityExtended)
+	if err != nil {
+		return err
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	w := prefixWriter{tw}
+
+	w.write(0, "Name:\t%s\n", m.GetName())
+	if m.GetNamespace() != "" {
+		w.write(0, "Namespace:\t%s\n", m.GetNamespace())
+	}
+	w.write(0, "Kind:\t%s\n", o.GetObjectKind().GroupVersionKind().Kind)
+	w.writeMap(0, "Labels", m.GetLabels())
+	w.writeMap(0, "Annotations", m.GetAnnotations())
+	if ts := m.GetCreationTimestamp(); !ts.IsZero() {
+		w.write(0, "Created:\t%s (%s ago)\n", ts.Time.Format(time.RFC1123Z), translateTimestampSince(ts))
+	}
+	if ref := metav1.GetControllerOfNoCopy(m); ref != nil {
+		w.write(0, "Controlled By:\t%s/%s\n", ref.Kind, ref.Name)
+	}
+	w.write(0, "Health:\t%s\n", row.Health)
+
+	for _, col := range columnsOf(c, row.Cells) {
+		if v, ok := row.Cells[col.Name]; ok && !describedColumns[col.Name] {
+			w.write(0, "%s:\t%s\n", col.Name, DisplayValue(v))
+		}
+	}
+
+	if spec, ok := podSpecOf(o); ok {
+		details, _ := row.Cells[ContainerDetailsKey].(SubTable)
+		w.writeContainers("Init Containers", spec.InitContainers, "Init", details)
+		w.writeContainers("Containers", spec.Containers, "Container", details)
+		w.writeVolumes(spec.Volumes)
+	}
+	if node, ok := o.(*core.Node); ok {
+		w.write(0, "Addresses:\n")
+		for _, addr := range node.Status.Addresses {
+			w.write(1, "%s:\t%s\n", addr.Type, addr.Address)
+		}
+		w.writeResources(0, "Capacity", node.Status.Capacity)
+		w.writeResources(0, "Allocatable", node.Status.Allocatable)
+	}
+	if err := w.writeConditions(o); err != nil {
+		return err
+	}
+	if len(events) > 0 {
+		w.writeEvents(EventsFor(o, events))
+	}
+	return tw.Flush()
+}
+
+// prefixWriter writes lines indented by level, like the describers of
+// kubectl. Tabs align the values of a section.
+type prefixWriter struct {
+	out io.Writer
+}
+
+func (w prefixWriter) write(level int, format string, a ...interface{}) {
+	fmt.Fprintf(w.out, strings.Repeat("  ", level)+format, a...)
+}
+
+// writeMap writes the entries of m sorted by key, one per line.
+func (w prefixWriter) writeMap(level int, title string, m map[string]string) {
+	if len(m) == 0 {
+		w.write(level, "%s:\t<none>\n", title)
+		return
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i == 0 {
+			w.write(level, "%s:\t%s=%s\n", title, k, m[k])
+		} else {
+			w.write(level, "\t%s=%s\n", k, m[k])
+		}
+	}
+}
+
+func (w prefixWriter) writeResources(level int, title string, list core.ResourceList) {
+	if len(list) == 0 {
+		return
+	}
+	w.write(level, "%s:\n", title)
+	names := make([]string, 0, len(list))
+	for name := range list {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		q := list[core.ResourceName(name)]
+		w.write(level+1, "%s:\t%s\n", name, q.String())
+	}
+}
+
+// writeContainers writes the containers of a pod template. The state of
+// the containers of pods is taken from the Container Details of their row.
+func (w prefixWriter) writeContainers(title string, containers []core.Container, containerType string, details SubTable) {
+	if len(containers) == 0 {
+		return
+	}
+	w.write(0, "%s:\n", title)
+	for _, c := range containers {
+		w.write(1, "%s:\n", c.Name)
+		w.write(2, "Image:\t%s\n", c.Image)
+		for _, d := range details.Rows {
+			if d["Type"] != containerType || d["Name"] != c.Name {
+				continue
+			}
+			w.write(2, "Image ID:\t%s\n", DisplayValue(d["Image ID"]))
+			w.write(2, "State:\t%s\n", DisplayValue(d["State"]))
+			w.write(2, "Reason:\t%s\n", DisplayValue(d["Reason"]))
+			w.write(2, "Ready:\t%s\n", DisplayValue(d["Ready"]))
+			w.write(2, "Restart Count:\t%s\n", DisplayValue(d["Restarts"]))
+		}
+		if len(c.Ports) > 0 {
+			ports := make([]string, len(c.Ports))
+			for i, p := range c.Ports {
+				ports[i] = fmt.Sprintf("%d/%s", p.ContainerPort, p.Protocol)
+			}
+			w.write(2, "Ports:\t%s\n", strings.Join(ports, ", "))
+		}
+		if len(c.Command) > 0 {
+			w.write(2, "Command:\t%s\n", strings.Join(c.Command, " "))
+		}
+		if len(c.Args) > 0 {
+			w.write(2, "Args:\t%s\n", strings.Join(c.Args, " "))
+		}
+		w.writeResources(2, "Limits", c.Resources.Limits)
+		w.writeResources(2, "Requests", c.Resources.Requests)
+		if len(c.Env) > 0 {
+			w.write(2, "Environment:\n")
+			for _, env := range c.Env {
+				w.write(3, "%s:\t%s\n", env.Name, envValue(env))
+			}
+		}
+		if len(c.VolumeMounts) > 0 {
+			w.write(2, "Mounts:\n")
+			for _, vm := range c.VolumeMounts {
+				flags := "rw"
+				if vm.ReadOnly {
+					flags = "ro"
+				}
+				w.write(3, "%s from %s (%s)\n", vm.MountPath, vm.Name, flags)
+			}
+		}
+	}
+}
+
+func envValue(env core.EnvVar) string {
+	from := env.ValueFrom
+	switch {
+	case from == nil:
+		return env.Value
+	case from.FieldRef != nil:
+		return fmt.Sprintf("(%s:%s)", from.FieldRef.APIVersion, from.FieldRef.FieldPath)
+	case from.ResourceFieldRef != nil:
+		return fmt.Sprintf("%s of container %s", from.ResourceFieldRef.Resource, from.ResourceFieldRef.ContainerName)
+	case from.SecretKeyRef != nil:
+		return fmt.Sprintf("<set to the key '%s' in secret '%s'>", from.SecretKeyRef.Key, from.SecretKeyRef.Name)
+	case from.ConfigMapKeyRef != nil:
+		return fmt.Sprintf("<set to the key '%s' of config map '%s'>", from.ConfigMapKeyRef.Key, from.ConfigMapKeyRef.Name)
+	}
+	return "<unknown>"
+}
+
+// writeVolumes writes the volumes of a pod template with the type of their
+// source, e.g. ConfigMap or PersistentVolumeClaim.
+func (w prefixWriter) writeVolumes(volumes []core.Volume) {
+	if len(volumes) == 0 {
+		w.write(0, "Volumes:\t<none>\n")
+		return
+	}
+	w.write(0, "Volumes:\n")
+	for _, vol := range volumes {
+		w.write(1, "%s:\n", vol.Name)
+		src := reflect.ValueOf(vol.VolumeSource)
+		for i := 0; i < src.NumField(); i++ {
+			if !src.Field(i).IsNil() {
+				w.write(2, "Type:\t%s\n", src.Type().Field(i).Name)
+			}
+		}
+		switch {
+		case vol.ConfigMap != nil:
+			w.write(2, "Name:\t%s\n", vol.ConfigMap.Name)
+		case vol.Secret != nil:
+			w.write(2, "SecretName:\t%s\n", vol.Secret.SecretName)
+		case vol.PersistentVolumeClaim != nil:
+			w.write(2, "ClaimName:\t%s\n", vol.PersistentVolumeClaim.ClaimName)
+		case vol.HostPath != nil:
+			w.write(2, "Path:\t%s\n", vol.HostPath.Path)
+		}
+	}
+}
+
+// writeConditions writes status.conditions, which most kinds share the
+// shape of.
+func (w prefixWriter) writeConditions(o runtime.Object) error {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return err
+	}
+	conditions, found, err := unstructured.NestedSlice(u, "status", "conditions")
+	if err != nil || !found || len(conditions) == 0 {
+		return nil
+	}
+
+	w.write(0, "Conditions:\n")
+	w.write(1, "Type\tStatus\tReason\tMessage\n")
+	w.write(1, "----\t------\t------\t-------\n")
+	for _, c := range conditions {
+		c, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		text := func(key string) string {
+			if s, ok := c[key].(string); ok && s != "" {
+				return s
+			}
+			return "<none>"
+		}
+		w.write(1, "%s\t%s\t%s\t%s\n", text("type"), text("status"), text("reason"), text("message"))
+	}
+	return nil
+}
+
+func (w prefixWriter) writeEvents(events []core.Event) {
+	if len(events) == 0 {
+		w.write(0, "Events:\t<none>\n")
+		return
+	}
+	w.write(0, "Events:\n")
+	w.write(1, "Type\tReason\tAge\tFrom\tMessage\n")
+	w.write(1, "----\t------\t----\t----\t-------\n")
+	for _, e := range events {
+		age := translateTimestampSince(eventTime(e))
+		if e.Count > 1 && !e.FirstTimestamp.IsZero() {
+			age = fmt.Sprintf("%s (x%d over %s)", age, e.Count, translateTimestampSince(e.FirstTimestamp))
+		}
+		w.write(1, "%s\t%s\t%s\t%s\t%s\n", e.Type, e.Reason, age, eventSource(e), strings.TrimSpace(e.Message))
+	}
+}
+
+// podSpecOf returns the pod spec or pod template spec of o.
+func podSpecOf(o runtime.Object) (*core.PodSpec, bool) {
+	switch o := o.(type) {
+	case *core.Pod:
+		return &o.Spec, true
+	case *core.PodTemplate:
+		return &o.Template.Spec, true
+	case *core.ReplicationController:
+		if o.Spec.Template != nil {
+			return &o.Spec.Template.Spec, true
+		}
+	case *apps.Deployment:
+		return &o.Spec.Template.Spec, true
+	case *apps.ReplicaSet:
+		return &o.Spec.Template.Spec, true
+	case *apps.StatefulSet:
+		return &o.Spec.Template.Spec, true
+	case *apps.DaemonSet:
+		return &o.Spec.Template.Spec, true
+	case *batch.Job:
+		return &o.Spec.Template.Spec, true
+	case *batchv1beta1.CronJob:
+		return &o.Spec.JobTemplate.Spec.Template.Spec, true
+	}
+	return nil, false
+}
@@ -0,0 +1,138 @@
+package printers
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const changeColumn = "Change"
+
+// Change is how a row differs between two snapshots.
+type Change string
+
+const (
+	ChangeAdded     Change = "Added"
+	ChangeRemoved   Change = "Removed"
+	ChangeChanged   Change = "Changed"
+	ChangeUnchanged Change = "Unchanged"
+)
+
+// CellChange is a cell whose value differs between two snapshots. It
+// prints as "old → new", e.g. "3/3 → 1/3". It has no raw value in the row
+// schema, so JSONWriter does not write diffs.
+type CellChange struct {
+	Old interface{}
+	New interface{}
+}
+
+func (c CellChange) String() string {
+	return DisplayValue(c.Old) + " → " + DisplayValue(c.New)
+}
+
+// DiffTables compares two snapshots of objects. Objects are matched by GVK,
+// namespace and name, and a Table is returned per GVK with a Change column
+// in front of the converter columns. In changed rows, cells whose raw value
+// changed hold a CellChange; values that only grow with the current time,
+// like the duration of a running Job, are not changes. Added rows hold the
+// cells of after and removed rows those of before. Unchanged rows are left
+// out unless withUnchanged is set. Rows are in the order of after, followed
+// by the removed rows in the order of before.
+func DiffTables(priority int32, before, after []runtime.Object, withUnchanged bool) ([]Table, error) {
+	oldTables, err := NewTables(priority, before...)
+	if err != nil {
+		return nil, err
+	}
+	newTables, err := NewTables(priority, after...)
+	if err != nil {
+		return nil, err
+	}
+
+	type rowKey struct {
+		gvk             schema.GroupVersionKind
+		namespace, name string
+	}
+	oldRows := map[rowKey]Row{}
+	for _, t := range oldTables {
+		for _, row := range t.Rows {
+			ns, name := row.namespaceName()
+			oldRows[rowKey{t.GVK, ns, name}] = row
+		}
+	}
+
+	var result []Table
+	index := map[schema.GroupVersionKind]int{}
+	tableFor := func(t Table) *Table {
+		i, ok := index[t.GVK]
+		if !ok {
+			i = len(result)
+			index[t.GVK] = i
+			columns := append([]metav1.TableColumnDefinition{{
+				Name:        changeColumn,
+				Type:        "string",
+				Description: "How the object changed between the snapshots: Added, Removed, Changed or Unchanged.",
+			}}, t.Columns...)
+			result = append(result, Table{GVK: t.GVK, Columns: columns})
+		}
+		return &result[i]
+	}
+
+	matched := map[rowKey]bool{}
+	for _, t := range newTables {
+		for _, row := range t.Rows {
+			ns, name := row.namespaceName()
+			key := rowKey{t.GVK, ns, name}
+			old, ok := oldRows[key]
+			if !ok {
+				tableFor(t).Rows = append(tableFor(t).Rows, changedRow(row, ChangeAdded))
+				continue
+			}
+			matched[key] = true
+
+			cells := map[string]interface{}{}
+			change := ChangeUnchanged
+			for k, v := range row.Cells {
+				cells[k] = v
+				if !sameValue(v, old.Cells[k]) {
+					cells[k] = CellChange{Old: old.Cells[k], New: v}
+					change = ChangeChanged
+				}
+			}
+			if change == ChangeUnchanged && !withUnchanged {
+				continue
+			}
+			cells[changeColumn] = change
+			tableFor(t).Rows = append(tableFor(t).Rows, Row{Object: row.Object, Cells: cells, Health: row.Health})
+		}
+	}
+	for _, t := range oldTables {
+		for _, row := range t.Rows {
+			ns, name := row.namespaceName()
+			if !matched[rowKey{t.GVK, ns, name}] {
+				tableFor(t).Rows = append(tableFor(t).Rows, changedRow(row, ChangeRemoved))
+			}
+		}
+	}
+	return result, nil
+}
+
+func changedRow(row Row, change Change) Row {
+	cells := make(map[string]interface{}, len(row.Cells)+1)
+	for k, v := range row.Cells {
+		cells[k] = v
+	}
+	cells[changeColumn] = change
+	return Row{Object: row.Object, Cells: cells, Health: row.Health}
+}
+
+func changeHighlight(change Change) HealthLevel {
+	switch change {
+	case ChangeAdded:
+		return HealthOK
+	case ChangeRemoved:
+		return HealthError
+	case ChangeChanged:
+		return HealthProgressing
+	}
+	return ""
+}
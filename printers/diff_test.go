@@ -0,0 +1,89 @@
+package printers
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestDiffTables(t *testing.T) {
+	tests := []struct {
+		name          string
+		withUnchanged bool
+		want          map[string]Change
+	}{
+		{
+			name: "changes",
+			want: map[string]Change{
+				"web": ChangeChanged,
+				"api": ChangeAdded,
+				"old": ChangeRemoved,
+			},
+		},
+		{
+			// the Duration of the running Job grows with time alone
+			name:          "with unchanged",
+			withUnchanged: true,
+			want: map[string]Change{
+				"web":     ChangeChanged,
+				"api":     ChangeAdded,
+				"old":     ChangeRemoved,
+				"running": ChangeUnchanged,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := DiffTables(PriorityWide, decodeFile(t, "diff/before.yaml"), decodeFile(t, "diff/after.yaml"), tt.withUnchanged)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[string]Change{}
+			for _, table := range tables {
+				if table.Columns[0].Name != changeColumn {
+					t.Errorf("%v: got first column %q, want %q", table.GVK, table.Columns[0].Name, changeColumn)
+				}
+				for _, row := range table.Rows {
+					_, name := row.namespaceName()
+					got[name] = row.Cells[changeColumn].(Change)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffTablesCells(t *testing.T) {
+	tables, err := DiffTables(PriorityWide, decodeFile(t, "diff/before.yaml"), decodeFile(t, "diff/after.yaml"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	web := tables[0].Rows[0]
+	tests := []struct {
+		column  string
+		want    string
+		changed bool
+	}{
+		{column: "Ready", want: "3/3 → 1/3", changed: true},
+		{column: "Up-to-date", want: "3 → 1", changed: true},
+		{column: "Images", want: "nginx:1.19 → nginx:1.21", changed: true},
+		{column: "Containers", want: "web"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			v := web.Cells[tt.column]
+			if got := DisplayValue(v); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+			if _, changed := v.(CellChange); changed != tt.changed {
+				t.Errorf("got CellChange %v, want %v", changed, tt.changed)
+			}
+		})
+	}
+
+	if err := (JSONWriter{}).Write(&bytes.Buffer{}, tables...); err == nil {
+		t.Error("expected JSONWriter to refuse a diff")
+	}
+}
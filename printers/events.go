@@ -0,0 +1,125 @@
+package printers
+
+import (
+	"sort"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	lastEventColumn = "Last Event"
+	// lastEventWidth is the length Last Event cells are cut to. The raw
+	// value of the cells holds the whole message.
+	lastEventWidth = 80
+)
+
+// SplitEvents separates the core/v1 Events among objs from the other
+// objects.
+func SplitEvents(objs []runtime.Object) ([]runtime.Object, []core.Event) {
+	var rest []runtime.Object
+	var events []core.Event
+	for _, o := range objs {
+		if e, ok := o.(*core.Event); ok {
+			events = append(events, *e)
+		} else {
+			rest = append(rest, o)
+		}
+	}
+	return rest, events
+}
+
+// WithLastEvents returns a copy of t with a Last Event column that holds
+// the type, reason and message of the most recent of the given events that
+// involves the object of each row, e.g. "Warning FailedScheduling: 0/3
+// nodes are available". Rows without an object or events are left empty.
+func WithLastEvents(t Table, events []core.Event) Table {
+	result := Table{
+		GVK: t.GVK,
+		Columns: append(t.Columns[:len(t.Columns):len(t.Columns)], metav1.TableColumnDefinition{
+			Name:        lastEventColumn,
+			Type:        "string",
+			Description: "The type, reason and message of the most recent event involving the object.",
+		}),
+		Rows: make([]Row, len(t.Rows)),
+	}
+	for i, row := range t.Rows {
+		cells := make(map[string]interface{}, len(row.Cells)+1)
+		for k, v := range row.Cells {
+			cells[k] = v
+		}
+		cells[lastEventColumn] = nil
+		if row.Object != nil {
+			if matched := EventsFor(row.Object, events); len(matched) > 0 {
+				cells[lastEventColumn] = lastEventCell(matched[len(matched)-1])
+			}
+		}
+		result.Rows[i] = Row{Object: row.Object, Cells: cells, Health: row.Health}
+	}
+	return result
+}
+
+func lastEventCell(e core.Event) Cell {
+	text := e.Type + " " + e.Reason
+	if msg := strings.Join(strings.Fields(e.Message), " "); msg != "" {
+		text += ": " + msg
+	}
+	display := text
+	if r := []rune(text); len(r) > lastEventWidth {
+		display = string(r[:lastEventWidth-3]) + "..."
+	}
+	return Cell{Raw: text, Display: display}
+}
+
+// EventsFor returns the events that involve o, oldest first. Events are
+// matched by the kind, namespace and name of o, and by its UID unless o or
+// the event has none.
+func EventsFor(o runtime.Object, events []core.Event) []core.Event {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil
+	}
+	kind := o.GetObjectKind().GroupVersionKind().Kind
+
+	var result []core.Event
+	for _, e := range events {
+		ref := e.InvolvedObject
+		if ref.Kind != kind || ref.Namespace != m.GetNamespace() || ref.Name != m.GetName() {
+			continue
+		}
+		if ref.UID != "" && m.GetUID() != "" && ref.UID != m.GetUID() {
+			// an event of an earlier object of the same name
+			continue
+		}
+		result = append(result, e)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return eventTime(result[i]).Time.Before(eventTime(result[j]).Time)
+	})
+	return result
+}
+
+// eventTime returns when an event last occurred.
+func eventTime(e core.Event) metav1.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp
+	case !e.EventTime.IsZero():
+		return metav1.Time{Time: e.EventTime.Time}
+	}
+	return e.CreationTimestamp
+}
+
+func eventSource(e core.Event) string {
+	source := e.Source.Component
+	if source == "" {
+		source = e.ReportingController
+	}
+	if e.Source.Host != "" {
+		source += ", " + e.Source.Host
+	}
+	return source
+}
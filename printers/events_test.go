@@ -0,0 +1,145 @@
+package printers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWithLastEvents(t *testing.T) {
+	at := func(minute int) metav1.Time {
+		return metav1.NewTime(time.Date(2026, 10, 19, 9, minute, 0, 0, time.UTC))
+	}
+	event := func(ref core.ObjectReference, typ, reason, message string, last metav1.Time) core.Event {
+		return core.Event{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: ref.Namespace, Name: ref.Name + "." + reason},
+			InvolvedObject: ref,
+			Type:           typ,
+			Reason:         reason,
+			Message:        message,
+			LastTimestamp:  last,
+		}
+	}
+	deployment := &apps.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "cart", UID: "d-1"},
+	}
+	service := &core.Service{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "shop", Name: "cart"},
+	}
+	long := "Back-off pulling image \"registry.example.com/shop/cart:2026.10.19-rc.1\" for the ninth time in a row"
+
+	tests := []struct {
+		name   string
+		obj    runtime.Object
+		events []core.Event
+		raw    interface{}
+		want   string
+	}{
+		{
+			name: "most recent event",
+			obj:  deployment,
+			events: []core.Event{
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart"}, "Normal", "ScalingReplicaSet", "Scaled up replica set cart-7c9 to 3", at(5)),
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart"}, "Warning", "ReplicaSetCreateError", "Failed to create new replica set", at(9)),
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart"}, "Normal", "ScalingReplicaSet", "Scaled down replica set cart-5f2 to 0", at(7)),
+			},
+			raw:  "Warning ReplicaSetCreateError: Failed to create new replica set",
+			want: "Warning ReplicaSetCreateError: Failed to create new replica set",
+		},
+		{
+			name: "matched on kind, not on API version",
+			obj:  deployment,
+			events: []core.Event{
+				event(core.ObjectReference{APIVersion: "extensions/v1beta1", Kind: "Deployment", Namespace: "shop", Name: "cart", UID: "d-1"}, "Normal", "DeploymentRollback", "Rolled back to revision 4", at(1)),
+			},
+			raw:  "Normal DeploymentRollback: Rolled back to revision 4",
+			want: "Normal DeploymentRollback: Rolled back to revision 4",
+		},
+		{
+			name: "events of other kinds, namespaces and UIDs",
+			obj:  deployment,
+			events: []core.Event{
+				event(core.ObjectReference{Kind: "Service", Namespace: "shop", Name: "cart"}, "Warning", "SyncLoadBalancerFailed", "quota exceeded", at(3)),
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "staging", Name: "cart"}, "Warning", "FailedCreate", "forbidden", at(4)),
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart", UID: types.UID("d-0")}, "Warning", "FailedCreate", "an earlier cart", at(5)),
+			},
+			raw:  nil,
+			want: "<none>",
+		},
+		{
+			name: "object without UID",
+			obj:  service,
+			events: []core.Event{
+				event(core.ObjectReference{Kind: "Service", Namespace: "shop", Name: "cart", UID: "s-9"}, "Warning", "SyncLoadBalancerFailed", "quota exceeded", at(3)),
+			},
+			raw:  "Warning SyncLoadBalancerFailed: quota exceeded",
+			want: "Warning SyncLoadBalancerFailed: quota exceeded",
+		},
+		{
+			name: "long message",
+			obj:  deployment,
+			events: []core.Event{
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart"}, "Warning", "BackOff", long, at(2)),
+			},
+			raw:  "Warning BackOff: " + long,
+			want: ("Warning BackOff: " + long)[:lastEventWidth-3] + "...",
+		},
+		{
+			name: "multi-line message with wide runes",
+			obj:  deployment,
+			events: []core.Event{
+				event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart"}, "Normal", "Note", "über\n\n  "+strings.Repeat("ü", 70)+"\tend", at(2)),
+			},
+			raw:  "Normal Note: über " + strings.Repeat("ü", 70) + " end",
+			want: "Normal Note: über " + strings.Repeat("ü", lastEventWidth-3-len([]rune("Normal Note: über "))) + "...",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := NewTables(PriorityDefault, tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			in := tables[0]
+			got := WithLastEvents(in, tt.events)
+
+			if n := len(got.Columns); n != len(in.Columns)+1 || got.Columns[n-1].Name != lastEventColumn {
+				t.Fatalf("got columns %v, want a Last Event column after %d columns", got.Columns, len(in.Columns))
+			}
+			if _, ok := in.Rows[0].Cells[lastEventColumn]; ok {
+				t.Errorf("the input table was modified")
+			}
+			if got.Rows[0].Health != in.Rows[0].Health {
+				t.Errorf("got health %v, want %v", got.Rows[0].Health, in.Rows[0].Health)
+			}
+			cell := got.Rows[0].Cells[lastEventColumn]
+			if raw := RawValue(cell); raw != tt.raw {
+				t.Errorf("got raw value %q, want %q", raw, tt.raw)
+			}
+			if display := DisplayValue(cell); display != tt.want {
+				t.Errorf("got %q, want %q", display, tt.want)
+			}
+			if n := len([]rune(DisplayValue(cell))); n > lastEventWidth {
+				t.Errorf("cell is %d runes long, want at most %d", n, lastEventWidth)
+			}
+		})
+	}
+
+	t.Run("rows without objects", func(t *testing.T) {
+		summary := Table{Rows: []Row{{Cells: map[string]interface{}{"Count": int64(2)}, Health: Health{Level: HealthUnknown}}}}
+		got := WithLastEvents(summary, []core.Event{
+			event(core.ObjectReference{Kind: "Deployment", Namespace: "shop", Name: "cart"}, "Normal", "ScalingReplicaSet", "Scaled up", at(1)),
+		})
+		if v, ok := got.Rows[0].Cells[lastEventColumn]; !ok || v != nil {
+			t.Errorf("got Last Event %v, want an empty cell", v)
+		}
+	})
+}
@@ -0,0 +1,185 @@
+package printers
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// ColumnExtension adds a column to the rows of a kind, or overrides the
+// cells of one of its columns, without changing its converter. E.g.
+//
+//	team := printers.ColumnExtension{
+//		Column:   metav1.TableColumnDefinition{Name: "Team", Type: "string"},
+//		JSONPath: "{.metadata.labels.team}",
+//		After:    "Name",
+//	}
+//	if err := printers.Extend(core.SchemeGroupVersion.WithKind("Pod"), team); err != nil {
+//		return err
+//	}
+type ColumnExtension struct {
+	// Column is the definition of the column. If a column of the converter
+	// has the same name, compared case-insensitively, its cells are
+	// overridden and, unless Column has no Type, its definition is replaced
+	// in place.
+	Column metav1.TableColumnDefinition
+	// After is the name of the column a new column is inserted after. New
+	// columns without After, or whose After column does not exist, are
+	// appended.
+	After string
+	// JSONPath is evaluated against the object, e.g. {.metadata.labels.team}
+	// or .metadata.annotations.cost-center. It is not used if Func is set.
+	JSONPath string
+	// Func returns the cell of the column. row holds the cells of the
+	// converter and of the extensions registered before for the kind.
+	Func func(o runtime.Object, row map[string]interface{}) (interface{}, error)
+
+	jp *jsonpath.JSONPath
+}
+
+var extensions = map[schema.GroupVersionKind][]ColumnExtension{}
+
+// extended holds the converters of kinds with column extensions, as returned
+// by extend. It is updated when converters and extensions are registered, so
+// the columns are merged once per kind rather than for every object.
+var extended = map[schema.GroupVersionKind]ColumnConverter{}
+
+// Extend registers column extensions for the kind gvk. Extensions are
+// applied in the order they are registered, after the converter, so a later
+// extension sees the cells of earlier ones and wins if both override the
+// same column. Extensions apply to the converter registered for gvk at
+// conversion time, so they may be registered before or after it.
+func Extend(gvk schema.GroupVersionKind, exts ...ColumnExtension) error {
+	exts = append([]ColumnExtension(nil), exts...)
+	for i, ext := range exts {
+		if ext.Column.Name == "" {
+			return fmt.Errorf("column extension for %v has no column name", gvk)
+		}
+		if ext.Func == nil {
+			if ext.JSONPath == "" {
+				return fmt.Errorf("column extension %q for %v needs a Func or a JSONPath", ext.Column.Name, gvk)
+			}
+			jp, err := parseJSONPath(ext.JSONPath)
+			if err != nil {
+				return err
+			}
+			exts[i].jp = jp
+		}
+	}
+	extensions[gvk] = append(extensions[gvk], exts...)
+	updateExtended(gvk)
+	return nil
+}
+
+// ResetExtensions removes the column extensions registered for gvk.
+func ResetExtensions(gvk schema.GroupVersionKind) {
+	delete(extensions, gvk)
+	updateExtended(gvk)
+}
+
+// updateExtended extends the converter of gvk again after it or the
+// extensions of its kind changed.
+func updateExtended(gvk schema.GroupVersionKind) {
+	c, ok := printers[gvk]
+	if _, ext := extensions[gvk]; !ok || !ext {
+		delete(extended, gvk)
+		return
+	}
+	extended[gvk] = extend(c)
+}
+
+// lookup returns the converter registered for gvk with the column
+// extensions of its kind.
+func lookup(gvk schema.GroupVersionKind) (ColumnConverter, bool) {
+	if c, ok := extended[gvk]; ok {
+		return c, true
+	}
+	c, ok := printers[gvk]
+	return c, ok
+}
+
+// extendedConverter is a converter with the column extensions of its kind.
+type extendedConverter struct {
+	ColumnConverter
+	columns []metav1.TableColumnDefinition
+	exts    []ColumnExtension
+}
+
+var _ ColumnDefiner = extendedConverter{}
+var _ HealthConverter = extendedConverter{}
+
+// extend returns c with the column extensions registered for its kind, or
+// c itself if there are none.
+func extend(c ColumnConverter) ColumnConverter {
+	exts, ok := extensions[c.GVK()]
+	if !ok {
+		return c
+	}
+
+	columns := append([]metav1.TableColumnDefinition(nil), columnsOf(c)...)
+	resolved := make([]ColumnExtension, len(exts))
+	for i, ext := range exts {
+		if idx := columnIndex(columns, ext.Column.Name); idx >= 0 {
+			// cells are stored under the name of the converter column
+			ext.Column.Name = columns[idx].Name
+			if ext.Column.Type != "" {
+				columns[idx] = ext.Column
+			}
+		} else if idx := columnIndex(columns, ext.After); idx >= 0 {
+			columns = append(columns[:idx+1], append([]metav1.TableColumnDefinition{ext.Column}, columns[idx+1:]...)...)
+		} else {
+			columns = append(columns, ext.Column)
+		}
+		resolved[i] = ext
+	}
+	e := extendedConverter{ColumnConverter: c, columns: columns, exts: resolved}
+	if _, ok := c.(ColumnDefiner); !ok {
+		// hide Columns, so the columns are still taken from the cells
+		return struct {
+			ColumnConverter
+			HealthConverter
+		}{e, e}
+	}
+	return e
+}
+
+func columnIndex(columns []metav1.TableColumnDefinition, name string) int {
+	for i, col := range columns {
+		if strings.EqualFold(col.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c extendedConverter) Columns() []metav1.TableColumnDefinition {
+	return c.columns
+}
+
+func (c extendedConverter) Health(o runtime.Object) Health {
+	return healthOf(c.ColumnConverter, o)
+}
+
+func (c extendedConverter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	row, err := c.ColumnConverter.Convert(o)
+	if err != nil {
+		return nil, err
+	}
+	for _, ext := range c.exts {
+		var v interface{}
+		if ext.Func != nil {
+			v, err = ext.Func(o, row)
+		} else {
+			v, err = evalJSONPath(ext.jp, o)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("column %q of %v: %w", ext.Column.Name, c.GVK(), err)
+		}
+		row[ext.Column.Name] = v
+	}
+	return row, nil
+}
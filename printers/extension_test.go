@@ -0,0 +1,183 @@
+package printers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExtend(t *testing.T) {
+	svc := &core.Service{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "checkout",
+			Namespace:   "shop",
+			Labels:      map[string]string{"team": "payments"},
+			Annotations: map[string]string{"example.com/cost-center": "cc-42"},
+		},
+		Spec: core.ServiceSpec{Type: core.ServiceTypeNodePort, ClusterIP: "10.0.0.7"},
+	}
+	gvk := svc.GroupVersionKind()
+	team := ColumnExtension{
+		Column:   metav1.TableColumnDefinition{Name: "Team", Type: "string"},
+		JSONPath: "{.metadata.labels.team}",
+	}
+	costCenter := ColumnExtension{
+		Column:   metav1.TableColumnDefinition{Name: "Cost Center", Type: "string", Priority: 1},
+		JSONPath: `{.metadata.annotations.example\.com/cost-center}`,
+		After:    "Name",
+	}
+	upper := func(column string) func(runtime.Object, map[string]interface{}) (interface{}, error) {
+		return func(_ runtime.Object, row map[string]interface{}) (interface{}, error) {
+			return strings.ToUpper(DisplayValue(row[column])), nil
+		}
+	}
+
+	tests := []struct {
+		name    string
+		exts    [][]ColumnExtension
+		columns []string
+		cells   map[string]interface{}
+	}{
+		{
+			name:    "appended",
+			exts:    [][]ColumnExtension{{team}},
+			columns: []string{"Name", "Type", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector", "Team"},
+			cells:   map[string]interface{}{"Team": "payments"},
+		},
+		{
+			name:    "inserted after a column",
+			exts:    [][]ColumnExtension{{team, costCenter}},
+			columns: []string{"Name", "Cost Center", "Type", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector", "Team"},
+			cells:   map[string]interface{}{"Team": "payments", "Cost Center": "cc-42"},
+		},
+		{
+			name: "inserted after a missing column",
+			exts: [][]ColumnExtension{{{
+				Column:   metav1.TableColumnDefinition{Name: "Zone"},
+				JSONPath: "{.metadata.labels.zone}",
+				After:    "Node",
+			}}},
+			columns: []string{"Name", "Type", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector", "Zone"},
+			cells:   map[string]interface{}{"Zone": nil},
+		},
+		{
+			name: "later columns after the same column go first",
+			exts: [][]ColumnExtension{
+				{{Column: metav1.TableColumnDefinition{Name: "Owner"}, After: "Type", JSONPath: "{.metadata.labels.team}"}},
+				{{Column: metav1.TableColumnDefinition{Name: "Shop"}, After: "Type", JSONPath: "{.metadata.namespace}"}},
+			},
+			columns: []string{"Name", "Type", "Shop", "Owner", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector"},
+			cells:   map[string]interface{}{"Owner": "payments", "Shop": "shop"},
+		},
+		{
+			name:    "overrides a converter column without changing its definition",
+			exts:    [][]ColumnExtension{{{Column: metav1.TableColumnDefinition{Name: "TYPE"}, Func: upper("Type")}}},
+			columns: []string{"Name", "Type", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector"},
+			cells:   map[string]interface{}{"Type": "NODEPORT"},
+		},
+		{
+			name: "later extensions see and override earlier ones",
+			exts: [][]ColumnExtension{
+				{team},
+				{{Column: metav1.TableColumnDefinition{Name: "Team", Type: "string", Priority: 1}, Func: upper("Team")}},
+			},
+			columns: []string{"Name", "Type", "Cluster-IP", "External-IP", "Port(s)", "Age", "Selector", "Team"},
+			cells:   map[string]interface{}{"Team": "PAYMENTS"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer ResetExtensions(gvk)
+			for _, exts := range tt.exts {
+				if err := Extend(gvk, exts...); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			columns, err := Columns(gvk)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, col := range columns {
+				names = append(names, col.Name)
+			}
+			if !reflect.DeepEqual(names, tt.columns) {
+				t.Errorf("got columns %v, want %v", names, tt.columns)
+			}
+
+			cells, err := Convert(svc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for name, want := range tt.cells {
+				if got, ok := cells[name]; !ok || !reflect.DeepEqual(got, want) {
+					t.Errorf("cell %q = %#v, want %#v", name, got, want)
+				}
+			}
+		})
+	}
+
+	if _, ok := extended[gvk]; ok {
+		t.Errorf("extended converter of %v was not removed by ResetExtensions", gvk)
+	}
+}
+
+func TestExtendBeforeRegister(t *testing.T) {
+	c := cellsOnlyConverter{}
+	defer func() {
+		ResetExtensions(c.GVK())
+		delete(printers, c.GVK())
+	}()
+
+	shape := ColumnExtension{Column: metav1.TableColumnDefinition{Name: "Shape"}, JSONPath: "{.metadata.labels.shape}"}
+	if err := Extend(c.GVK(), shape); err != nil {
+		t.Fatal(err)
+	}
+	Register(c)
+
+	o := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "gear", Labels: map[string]string{"shape": "round"}}}
+	o.SetGroupVersionKind(c.GVK())
+	// PartialObjectMetadata would be looked up as such by converterFor
+	cc, ok := lookup(c.GVK())
+	if !ok {
+		t.Fatalf("no converter registered for %v", c.GVK())
+	}
+	row, err := convert(cc, o, PriorityWide)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if row.Cells["Shape"] != "round" {
+		t.Errorf("got cells %v, want Shape round", row.Cells)
+	}
+}
+
+func TestExtendErrors(t *testing.T) {
+	gvk := core.SchemeGroupVersion.WithKind("Pod")
+	tests := []struct {
+		name string
+		ext  ColumnExtension
+		err  string
+	}{
+		{name: "no name", ext: ColumnExtension{JSONPath: "{.metadata.name}"}, err: "has no column name"},
+		{name: "no value", ext: ColumnExtension{Column: metav1.TableColumnDefinition{Name: "Node"}}, err: "needs a Func or a JSONPath"},
+		{name: "bad JSONPath", ext: ColumnExtension{Column: metav1.TableColumnDefinition{Name: "Node"}, JSONPath: "{.spec.nodeName"}, err: "unclosed action"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer ResetExtensions(gvk)
+			err := Extend(gvk, tt.ext)
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("got error %v, want %q", err, tt.err)
+			}
+			if _, ok := extensions[gvk]; ok {
+				t.Errorf("invalid extension was registered")
+			}
+		})
+	}
+}
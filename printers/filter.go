@@ -0,0 +1,566 @@
+package printers
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+var humanDurationRegex = regexp.MustCompile(`^(\d+y)?(\d+d)?(\d+h)?(\d+m)?(\d+s)?$`)
+
+// Filter is a parsed row filter expression, see ParseFilter.
+type Filter struct {
+	expr string
+	root filterNode
+}
+
+// ParseFilter parses a filter expression over the cells of a row and its
+// source object, e.g.
+//
+//	Status != "Running" && Restarts > 3
+//	Age > 7d || .spec.nodeName == "node-1"
+//	Images =~ "^nginx:" && !(`Nominated Node` == null)
+//
+// Operands are column names, which are matched ignoring case and may be
+// quoted with backticks, JSONPath expressions, string, number and duration
+// literals, true, false and null. Comparisons use the raw values of cells
+// with the rules of SortRows. A string literal compared to a ratio or a
+// resource quantity is parsed as one, so Ready == "1/3" and
+// `CPU Requests` > "500m" work as expected. A time compared to a duration is
+// compared by its age, so Age > 7d matches objects created more than a week
+// ago. =~ and !~ match a regular expression. Rows whose cell is missing
+// never satisfy <, <=, > or >=.
+func ParseFilter(expr string) (*Filter, error) {
+	p := &filterParser{lexer: filterLexer{input: expr}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter %q: %w", expr, err)
+	}
+	if p.tok.kind != tokEOF {
+		return nil, fmt.Errorf("invalid filter %q: unexpected %s", expr, p.tok)
+	}
+	return &Filter{expr: expr, root: root}, nil
+}
+
+func (f *Filter) String() string {
+	return f.expr
+}
+
+// Match reports whether row of t satisfies the filter.
+func (f *Filter) Match(t Table, row Row) (bool, error) {
+	return f.match(t, row, time.Now())
+}
+
+func (f *Filter) match(t Table, row Row, now time.Time) (bool, error) {
+	v, err := f.root.eval(filterEnv{table: t, row: row, now: now})
+	if err != nil {
+		return false, err
+	}
+	return truthy(v), nil
+}
+
+// FilterRows returns a copy of t with the rows that satisfy f.
+func FilterRows(t Table, f *Filter) (Table, error) {
+	now := time.Now()
+	result := Table{GVK: t.GVK, Columns: t.Columns}
+	for _, row := range t.Rows {
+		ok, err := f.match(t, row, now)
+		if err != nil {
+			return Table{}, err
+		}
+		if ok {
+			result.Rows = append(result.Rows, row)
+		}
+	}
+	return result, nil
+}
+
+type filterEnv struct {
+	table Table
+	row   Row
+	now   time.Time
+}
+
+type filterNode interface {
+	eval(env filterEnv) (interface{}, error)
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n literalNode) eval(_ filterEnv) (interface{}, error) {
+	return n.value, nil
+}
+
+type columnNode struct {
+	name string
+}
+
+func (n columnNode) eval(env filterEnv) (interface{}, error) {
+	col, ok := findColumn(env.table.Columns, n.name)
+	if !ok {
+		return nil, fmt.Errorf("column %q not found for %v", n.name, env.table.GVK)
+	}
+	return env.row.Cells[col.Name], nil
+}
+
+type jsonPathNode struct {
+	jp *jsonpath.JSONPath
+}
+
+func (n jsonPathNode) eval(env filterEnv) (interface{}, error) {
+	return evalJSONPath(n.jp, env.row.Object)
+}
+
+type notNode struct {
+	x filterNode
+}
+
+func (n notNode) eval(env filterEnv) (interface{}, error) {
+	v, err := n.x.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return !truthy(v), nil
+}
+
+type logicNode struct {
+	and  bool
+	l, r filterNode
+}
+
+func (n logicNode) eval(env filterEnv) (interface{}, error) {
+	l, err := n.l.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if truthy(l) != n.and {
+		// short-circuit: false && ..., true || ...
+		return truthy(l), nil
+	}
+	r, err := n.r.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return truthy(r), nil
+}
+
+type compareNode struct {
+	op   string
+	l, r filterNode
+	re   *regexp.Regexp
+}
+
+func (n compareNode) eval(env filterEnv) (interface{}, error) {
+	l, err := n.l.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if n.re != nil {
+		return n.re.MatchString(matchText(l)) == (n.op == "=~"), nil
+	}
+	r, err := n.r.eval(env)
+	if err != nil {
+		return nil, err
+	}
+
+	kl, kr := filterKeyOf(l, r, env.now), filterKeyOf(r, l, env.now)
+	if kl.kind != kr.kind {
+		// values of different types are never equal nor ordered
+		return n.op == "!=", nil
+	}
+	c := compareKeys(kl, kr)
+	switch n.op {
+	case "==":
+		return c == 0, nil
+	case "!=":
+		return c != 0, nil
+	}
+	if kl.kind == sortMissing {
+		return false, nil
+	}
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+	return c >= 0, nil
+}
+
+// filterKeyOf returns the sort key of v, with times turned into their age
+// if other is a duration, and literals converted to the ratio or quantity
+// they are compared with.
+func filterKeyOf(v, other interface{}, now time.Time) sortKey {
+	k, ko := sortKeyOf(v), sortKeyOf(other)
+	switch {
+	case k.kind == sortTime && ko.kind == sortDuration:
+		return sortKey{kind: sortDuration, num: float64(now.Sub(k.t))}
+	case k.kind == sortNumber && ko.kind == sortQuantity:
+		return sortKey{kind: sortQuantity, num: k.num}
+	case k.kind == sortString && ko.kind == sortQuantity:
+		if q, err := resource.ParseQuantity(k.str); err == nil {
+			return sortKeyOf(q)
+		}
+	case k.kind == sortString && ko.kind == sortRatio:
+		if m := ratioRegex.FindStringSubmatch(k.str); m != nil {
+			num, _ := strconv.ParseInt(m[1], 10, 64)
+			denom, _ := strconv.ParseInt(m[2], 10, 64)
+			return sortKeyOf(Ratio{Num: num, Denom: denom})
+		}
+	}
+	return k
+}
+
+// matchText returns the text regular expressions are matched against.
+func matchText(v interface{}) string {
+	switch raw := RawValue(v).(type) {
+	case string:
+		return raw
+	case []string:
+		return strings.Join(raw, ",")
+	case nil:
+		return ""
+	}
+	return DisplayValue(v)
+}
+
+func truthy(v interface{}) bool {
+	if b, ok := v.(bool); ok {
+		return b
+	}
+	k := sortKeyOf(v)
+	switch k.kind {
+	case sortMissing:
+		return false
+	case sortNumber, sortQuantity, sortDuration:
+		return k.num != 0
+	}
+	return true
+}
+
+type filterParser struct {
+	lexer filterLexer
+	tok   filterToken
+}
+
+func (p *filterParser) next() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *filterParser) parseOr() (filterNode, error) {
+	l, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && p.tok.text == "||" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		r, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l = logicNode{and: false, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *filterParser) parseAnd() (filterNode, error) {
+	l, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && p.tok.text == "&&" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		r, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		l = logicNode{and: true, l: l, r: r}
+	}
+	return l, nil
+}
+
+func (p *filterParser) parseUnary() (filterNode, error) {
+	if p.tok.kind == tokOp && p.tok.text == "!" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{x: x}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *filterParser) parseComparison() (filterNode, error) {
+	l, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokOp {
+		return l, nil
+	}
+	op := p.tok.text
+	switch op {
+	case "=":
+		op = "=="
+	case "==", "!=", "<", "<=", ">", ">=", "=~", "!~":
+	default:
+		return l, nil
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	if op == "=~" || op == "!~" {
+		if p.tok.kind != tokString {
+			return nil, fmt.Errorf("%s expects a string, found %s", op, p.tok)
+		}
+		re, err := regexp.Compile(p.tok.text)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		return compareNode{op: op, l: l, re: re}, nil
+	}
+	r, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	return compareNode{op: op, l: l, r: r}, nil
+}
+
+func (p *filterParser) parseOperand() (filterNode, error) {
+	tok := p.tok
+	var n filterNode
+	switch tok.kind {
+	case tokLParen:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokRParen {
+			return nil, fmt.Errorf("expected ), found %s", p.tok)
+		}
+		n = x
+	case tokIdent:
+		switch tok.text {
+		case "true":
+			n = literalNode{value: true}
+		case "false":
+			n = literalNode{value: false}
+		case "null":
+			n = literalNode{value: nil}
+		default:
+			n = columnNode{name: tok.text}
+		}
+	case tokColumn:
+		n = columnNode{name: tok.text}
+	case tokJSONPath:
+		jp, err := parseJSONPath(tok.text)
+		if err != nil {
+			return nil, err
+		}
+		n = jsonPathNode{jp: jp}
+	case tokString:
+		n = literalNode{value: tok.text}
+	case tokNumber:
+		f, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, err
+		}
+		n = literalNode{value: f}
+	case tokDuration:
+		d, ok := parseHumanDuration(tok.text)
+		if !ok {
+			var err error
+			if d, err = time.ParseDuration(tok.text); err != nil {
+				return nil, fmt.Errorf("invalid duration %q", tok.text)
+			}
+		}
+		n = literalNode{value: d}
+	default:
+		return nil, fmt.Errorf("unexpected %s", tok)
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+type filterTokenKind int
+
+const (
+	tokEOF filterTokenKind = iota
+	tokIdent
+	tokColumn
+	tokJSONPath
+	tokString
+	tokNumber
+	tokDuration
+	tokOp
+	tokLParen
+	tokRParen
+)
+
+type filterToken struct {
+	kind filterTokenKind
+	text string
+}
+
+func (t filterToken) String() string {
+	if t.kind == tokEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+type filterLexer struct {
+	input string
+	pos   int
+}
+
+var filterOps = []string{"&&", "||", "==", "!=", "<=", ">=", "=~", "!~", "<", ">", "=", "!"}
+
+func (l *filterLexer) next() (filterToken, error) {
+	for l.pos < len(l.input) && unicode.IsSpace(rune(l.input[l.pos])) {
+		l.pos++
+	}
+	if l.pos == len(l.input) {
+		return filterToken{kind: tokEOF}, nil
+	}
+
+	rest := l.input[l.pos:]
+	switch c := rest[0]; {
+	case c == '(':
+		l.pos++
+		return filterToken{kind: tokLParen, text: "("}, nil
+	case c == ')':
+		l.pos++
+		return filterToken{kind: tokRParen, text: ")"}, nil
+	case c == '"' || c == '\'':
+		end := 1
+		for end < len(rest) && rest[end] != c {
+			if rest[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(rest) {
+			return filterToken{}, fmt.Errorf("unterminated string at offset %d", l.pos)
+		}
+		text := rest[1:end]
+		if c == '"' {
+			s, err := strconv.Unquote(rest[:end+1])
+			if err != nil {
+				return filterToken{}, fmt.Errorf("invalid string %s", rest[:end+1])
+			}
+			text = s
+		}
+		l.pos += end + 1
+		return filterToken{kind: tokString, text: text}, nil
+	case c == '`':
+		end := strings.IndexByte(rest[1:], '`')
+		if end < 0 {
+			return filterToken{}, fmt.Errorf("unterminated column name at offset %d", l.pos)
+		}
+		l.pos += end + 2
+		return filterToken{kind: tokColumn, text: rest[1 : end+1]}, nil
+	case c == '{':
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return filterToken{}, fmt.Errorf("unterminated JSONPath at offset %d", l.pos)
+		}
+		l.pos += end + 1
+		return filterToken{kind: tokJSONPath, text: rest[:end+1]}, nil
+	case c == '.':
+		end := strings.IndexFunc(rest, func(r rune) bool {
+			return unicode.IsSpace(r) || strings.ContainsRune("()=!<>&|", r)
+		})
+		if end < 0 {
+			end = len(rest)
+		}
+		l.pos += end
+		return filterToken{kind: tokJSONPath, text: rest[:end]}, nil
+	case c >= '0' && c <= '9' || c == '-':
+		end := 1
+		for end < len(rest) && (rest[end] >= '0' && rest[end] <= '9' || rest[end] == '.') {
+			end++
+		}
+		kind := tokNumber
+		for end < len(rest) && unicode.IsLetter(rune(rest[end])) || end < len(rest) && kind == tokDuration && rest[end] >= '0' && rest[end] <= '9' {
+			kind = tokDuration
+			end++
+		}
+		l.pos += end
+		return filterToken{kind: kind, text: rest[:end]}, nil
+	case isIdentRune(rune(c)):
+		end := strings.IndexFunc(rest, func(r rune) bool { return !isIdentRune(r) })
+		if end < 0 {
+			end = len(rest)
+		}
+		l.pos += end
+		return filterToken{kind: tokIdent, text: rest[:end]}, nil
+	}
+
+	for _, op := range filterOps {
+		if strings.HasPrefix(rest, op) {
+			l.pos += len(op)
+			return filterToken{kind: tokOp, text: op}, nil
+		}
+	}
+	return filterToken{}, fmt.Errorf("unexpected character %q at offset %d", rest[0], l.pos)
+}
+
+func isIdentRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// parseHumanDuration parses the output of duration.HumanDuration, e.g.
+// "45s", "3m12s", "5d" or "2y10d".
+func parseHumanDuration(s string) (time.Duration, bool) {
+	m := humanDurationRegex.FindStringSubmatch(s)
+	if m == nil || s == "" {
+		return 0, false
+	}
+	units := []time.Duration{365 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if part := m[i+1]; part != "" {
+			n, err := strconv.ParseInt(part[:len(part)-1], 10, 64)
+			if err != nil {
+				return 0, false
+			}
+			d += time.Duration(n) * unit
+		}
+	}
+	return d, true
+}
@@ -0,0 +1,106 @@
+package printers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFilter(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want []string
+	}{
+		{expr: `Status != "Running"`, want: []string{"c"}},
+		{expr: `status == "running"`, want: nil},
+		{expr: `Restarts > 3`, want: []string{"b"}},
+		{expr: `Restarts >= 1`, want: []string{"b", "d"}},
+		// && binds tighter than ||
+		{expr: `Status == "Running" && Restarts > 0 || Age < 12h`, want: []string{"b", "c", "d"}},
+		{expr: `Status == "Running" && (Restarts > 0 || Age < 12h)`, want: []string{"b", "d"}},
+		{expr: `Age < 12h || Status == "Running" && Restarts > 0`, want: []string{"b", "c", "d"}},
+		// ! applies to the whole comparison
+		{expr: `!Status == "Running"`, want: []string{"c"}},
+		{expr: `!(Status == "Running" || Restarts > 0)`, want: []string{"c"}},
+		{expr: `{.spec.containers[*].image} =~ "^nginx"`, want: []string{"a", "c", "d"}},
+		{expr: `{.spec.containers[*].image} !~ "1\\.21"`, want: []string{"b", "d"}},
+		{expr: `Age > 7d`, want: []string{"a", "d"}},
+		{expr: `Age <= 2d`, want: []string{"b", "c"}},
+		{expr: `Ready == "0/1"`, want: []string{"b", "c"}},
+		{expr: `Ready < "1/1"`, want: []string{"b", "c"}},
+		{expr: "`CPU Requests` >= \"500m\"", want: []string{"a", "b", "d"}},
+		{expr: "`Memory Requests` > \"200Mi\"", want: []string{"a", "c"}},
+		{expr: `.spec.nodeName == "n1"`, want: []string{"a", "d"}},
+		{expr: `{.metadata.labels.app} == "cache"`, want: []string{"b"}},
+		{expr: "`Nominated Node` == null", want: []string{"a", "b", "d"}},
+		{expr: "!(`Nominated Node` == null)", want: []string{"c"}},
+		// rows with a missing cell never satisfy an ordering
+		{expr: `Node < "z"`, want: []string{"a", "b", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			tables, err := NewTables(PriorityExtended, decodeFile(t, "pods.yaml")...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := ParseFilter(tt.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, row := range tables[0].Rows {
+				ok, err := f.match(tables[0], row, now)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ok {
+					_, name := row.namespaceName()
+					got = append(got, name)
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFilterErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`Status ==`,
+		`== "Running"`,
+		`(Restarts > 1`,
+		`Restarts > 1)`,
+		`Restarts > 1 x`,
+		`Status === "Running"`,
+		"`Memory Requests` > 200Mi",
+		`Status == "Running`,
+		"`CPU Requests > 1",
+		`Images =~ "("`,
+		`Status == "Running" &&`,
+		`Status == "Running" & Restarts > 1`,
+	}
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			if f, err := ParseFilter(expr); err == nil {
+				t.Errorf("expected an error, got %v", f)
+			}
+		})
+	}
+}
+
+func TestFilterRowsUnknownColumn(t *testing.T) {
+	tables, err := NewTables(PriorityDefault, decodeFile(t, "pods.yaml")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := ParseFilter("`CPU Requests` > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FilterRows(tables[0], f); err == nil {
+		t.Error("expected an error for a column left out by the priority")
+	}
+}
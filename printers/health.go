@@ -0,0 +1,105 @@
+package printers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// HealthLevel is a normalized classification of an object's status.
+type HealthLevel string
+
+const (
+	HealthOK          HealthLevel = "OK"
+	HealthProgressing HealthLevel = "Progressing"
+	HealthWarning     HealthLevel = "Warning"
+	HealthError       HealthLevel = "Error"
+	HealthUnknown     HealthLevel = "Unknown"
+)
+
+// Health is the normalized status of a row, e.g. {Error, CrashLoopBackOff}.
+type Health struct {
+	Level  HealthLevel `json:"level"`
+	Reason string      `json:"reason,omitempty"`
+}
+
+func (h Health) String() string {
+	if h.Reason == "" {
+		return string(h.Level)
+	}
+	return fmt.Sprintf("%s (%s)", h.Level, h.Reason)
+}
+
+// healthOf returns the Health of o if c implements HealthConverter.
+func healthOf(c ColumnConverter, o runtime.Object) Health {
+	if h, ok := c.(HealthConverter); ok {
+		return h.Health(o)
+	}
+	return Health{Level: HealthUnknown}
+}
+
+var initProgressRegex = regexp.MustCompile(`^Init:\d+/\d+$`)
+
+// podHealth classifies the reason computed by PodPrinter.Convert.
+func podHealth(reason string, readyContainers, totalContainers int) Health {
+	switch {
+	case reason == "Running":
+		if readyContainers < totalContainers {
+			return Health{Level: HealthProgressing, Reason: "ContainersNotReady"}
+		}
+		return Health{Level: HealthOK, Reason: reason}
+	case reason == "Completed" || reason == "Succeeded":
+		return Health{Level: HealthOK, Reason: reason}
+	case reason == "Pending" || reason == "Terminating" || reason == "ContainerCreating" || reason == "PodInitializing":
+		return Health{Level: HealthProgressing, Reason: reason}
+	case initProgressRegex.MatchString(reason):
+		return Health{Level: HealthProgressing, Reason: reason}
+	case reason == "NotReady":
+		return Health{Level: HealthWarning, Reason: reason}
+	case reason == "Unknown" || reason == "":
+		return Health{Level: HealthUnknown, Reason: reason}
+	}
+	// Failed, Evicted, CrashLoopBackOff, ErrImagePull, ExitCode:1, Init:Error, ...
+	return Health{Level: HealthError, Reason: reason}
+}
+
+// replicasHealth classifies workloads by their ready vs. desired replicas.
+func replicasHealth(desired, ready int64) Health {
+	switch {
+	case ready >= desired:
+		return Health{Level: HealthOK}
+	case ready == 0:
+		return Health{Level: HealthWarning, Reason: "NoReplicasReady"}
+	}
+	return Health{Level: HealthProgressing, Reason: fmt.Sprintf("%d/%d replicas ready", ready, desired)}
+}
+
+// nodeHealth classifies the status computed by NodePrinter.Convert.
+func nodeHealth(status []string) Health {
+	reason := strings.Join(status, ",")
+	switch status[0] {
+	case "Ready":
+		if len(status) > 1 {
+			return Health{Level: HealthWarning, Reason: reason}
+		}
+		return Health{Level: HealthOK, Reason: reason}
+	case "NotReady":
+		return Health{Level: HealthError, Reason: reason}
+	}
+	return Health{Level: HealthUnknown, Reason: reason}
+}
+
+// pvcHealth classifies the phase computed by PersistentVolumeClaimPrinter.Convert.
+func pvcHealth(phase string) Health {
+	switch phase {
+	case "Bound":
+		return Health{Level: HealthOK, Reason: phase}
+	case "Pending", "Terminating":
+		return Health{Level: HealthProgressing, Reason: phase}
+	case "Lost":
+		return Health{Level: HealthError, Reason: phase}
+	}
+	return Health{Level: HealthUnknown, Reason: phase}
+}
@@ -0,0 +1,114 @@
+package printers
+
+import (
+	"html/template"
+	"io"
+	"strings"
+)
+
+// HTMLWriter writes tables as a self-contained HTML document. Rows and
+// highlighted cells carry health-* classes, e.g. health-error, that the
+// embedded style sheet colors.
+type HTMLWriter struct {
+	// Title of the document.
+	Title string
+	// GroupByKind writes a separate table with a heading per kind. Otherwise
+	// all kinds are written as one table with the union of their columns.
+	GroupByKind bool
+}
+
+type htmlTable struct {
+	Heading string
+	Columns []htmlCell
+	Rows    []htmlRow
+}
+
+type htmlRow struct {
+	Class string
+	Cells []htmlCell
+}
+
+type htmlCell struct {
+	Text  string
+	Class string
+}
+
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Title }}</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; font-size: 14px; }
+table { border-collapse: collapse; margin-bottom: 1.5em; }
+th, td { border: 1px solid #d0d7de; padding: 4px 10px; text-align: left; white-space: nowrap; }
+th { background: #f6f8fa; }
+td.number { text-align: right; }
+td.health-ok { color: #1a7f37; }
+td.health-progressing { color: #9a6700; }
+td.health-warning { color: #9a6700; font-weight: bold; }
+td.health-error { color: #cf222e; font-weight: bold; }
+</style>
+</head>
+<body>
+{{- range .Tables }}
+{{- if .Heading }}
+<h3>{{ .Heading }}</h3>
+{{- end }}
+<table>
+<thead>
+<tr>{{ range .Columns }}<th>{{ .Text }}</th>{{ end }}</tr>
+</thead>
+<tbody>
+{{- range .Rows }}
+<tr class="{{ .Class }}">{{ range .Cells }}<td{{ if .Class }} class="{{ .Class }}"{{ end }}>{{ .Text }}</td>{{ end }}</tr>
+{{- end }}
+</tbody>
+</table>
+{{- end }}
+</body>
+</html>
+`))
+
+func (w HTMLWriter) Write(out io.Writer, tables ...Table) error {
+	if !w.GroupByKind && len(tables) > 0 {
+		tables = []Table{mergeTables(tables)}
+	}
+
+	data := struct {
+		Title  string
+		Tables []htmlTable
+	}{Title: w.Title}
+	for _, t := range tables {
+		ht := htmlTable{}
+		if w.GroupByKind {
+			ht.Heading = t.GVK.GroupKind().String()
+		}
+		for _, col := range t.Columns {
+			ht.Columns = append(ht.Columns, htmlCell{Text: col.Name})
+		}
+		for _, row := range t.Rows {
+			cells := make([]htmlCell, len(t.Columns))
+			for j, col := range t.Columns {
+				var classes []string
+				if col.Type == "integer" || col.Type == "number" {
+					classes = append(classes, "number")
+				}
+				if level := cellHighlight(col.Name, row.Cells[col.Name], row.Health); level != "" {
+					classes = append(classes, healthClass(level))
+				}
+				if v, ok := row.Cells[col.Name]; ok {
+					cells[j].Text = DisplayValue(v)
+				}
+				cells[j].Class = strings.Join(classes, " ")
+			}
+			ht.Rows = append(ht.Rows, htmlRow{Class: healthClass(row.Health.Level), Cells: cells})
+		}
+		data.Tables = append(data.Tables, ht)
+	}
+	return htmlTemplate.Execute(out, data)
+}
+
+func healthClass(level HealthLevel) string {
+	return "health-" + strings.ToLower(string(level))
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -24,6 +25,17 @@ func (_ IngressPrinter) GVK() schema.GroupVersionKind {
 	return networking.SchemeGroupVersion.WithKind("Ingress")
 }
 
+func (_ IngressPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Class", Type: "string", Description: "The name of the IngressClass resource that should be used for additional configuration"},
+		{Name: "Hosts", Type: "string", Description: "Hosts that incoming requests are matched against before the ingress rule"},
+		{Name: "Address", Type: "string", Description: "Address is a list containing ingress points for the load-balancer"},
+		{Name: "Ports", Type: "string", Description: "Ports of TLS configurations that open"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+}
+
 func (p IngressPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*networking.Ingress)
 	if !ok {
@@ -32,17 +44,17 @@ func (p IngressPrinter) Convert(o runtime.Object) (map[string]interface{}, error
 
 	row := map[string]interface{}{}
 
-	className := "<none>"
+	className := ""
 	if obj.Spec.IngressClassName != nil {
 		className = *obj.Spec.IngressClassName
 	}
-	hosts := formatHosts(obj.Spec.Rules)
+	hosts := Cell{Raw: ingressHosts(obj.Spec.Rules), Display: formatHosts(obj.Spec.Rules)}
 	address := loadBalancerStatusStringer(obj.Status.LoadBalancer)
 	ports := formatPorts(obj.Spec.TLS)
-	createTime := translateTimestampSince(obj.CreationTimestamp)
+	createTime := timestampCell(obj.CreationTimestamp)
 
 	row["Name"] = obj.Name
-	row["Class"] = className
+	row["Class"] = optionalCell(className, "<none>")
 	row["Hosts"] = hosts
 	row["Address"] = address
 	row["Ports"] = ports
@@ -73,6 +85,16 @@ func formatHosts(rules []networking.IngressRule) string {
 	return ret
 }
 
+func ingressHosts(rules []networking.IngressRule) []string {
+	hosts := []string{}
+	for _, rule := range rules {
+		if len(rule.Host) != 0 {
+			hosts = append(hosts, rule.Host)
+		}
+	}
+	return hosts
+}
+
 func formatPorts(tls []networking.IngressTLS) string {
 	if len(tls) != 0 {
 		return "80, 443"
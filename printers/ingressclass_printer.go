@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	networking "k8s.io/api/networking/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -23,6 +24,15 @@ func (_ IngressClassPrinter) GVK() schema.GroupVersionKind {
 	return networking.SchemeGroupVersion.WithKind("IngressClass")
 }
 
+func (_ IngressClassPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Controller", Type: "string", Description: "Controller that is responsible for handling this class"},
+		{Name: "Parameters", Type: "string", Description: "A reference to a resource with additional parameters"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+}
+
 func (p IngressClassPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*networking.IngressClass)
 	if !ok {
@@ -31,7 +41,7 @@ func (p IngressClassPrinter) Convert(o runtime.Object) (map[string]interface{},
 
 	row := map[string]interface{}{}
 
-	parameters := "<none>"
+	parameters := ""
 	if obj.Spec.Parameters != nil {
 		parameters = obj.Spec.Parameters.Kind
 		if obj.Spec.Parameters.APIGroup != nil {
@@ -39,11 +49,11 @@ func (p IngressClassPrinter) Convert(o runtime.Object) (map[string]interface{},
 		}
 		parameters = parameters + "/" + obj.Spec.Parameters.Name
 	}
-	createTime := translateTimestampSince(obj.CreationTimestamp)
+	createTime := timestampCell(obj.CreationTimestamp)
 
 	row["Name"] = obj.Name
 	row["Controller"] = obj.Spec.Controller
-	row["Parameters"] = parameters
+	row["Parameters"] = optionalCell(parameters, "<none>")
 	row["Age"] = createTime
 
 	return row, nil
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -21,11 +22,24 @@ func init() {
 type JobPrinter struct{}
 
 var _ ColumnConverter = JobPrinter{}
+var _ HealthConverter = JobPrinter{}
 
 func (_ JobPrinter) GVK() schema.GroupVersionKind {
 	return batch.SchemeGroupVersion.WithKind("Job")
 }
 
+func (_ JobPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Completions", Type: "string", Description: batch.JobStatus{}.SwaggerDoc()["succeeded"]},
+		{Name: "Duration", Type: "string", Description: "Time required to complete the job."},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+		{Name: "Selector", Type: "string", Priority: 1, Description: batch.JobSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
 func (p JobPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*batch.Job)
 	if !ok {
@@ -34,33 +48,34 @@ func (p JobPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 
 	row := map[string]interface{}{}
 
-	var completions string
+	var completions Cell
 	if obj.Spec.Completions != nil {
-		completions = fmt.Sprintf("%d/%d", obj.Status.Succeeded, *obj.Spec.Completions)
+		completions = ratioCell(int64(obj.Status.Succeeded), int64(*obj.Spec.Completions))
 	} else {
 		parallelism := int32(0)
 		if obj.Spec.Parallelism != nil {
 			parallelism = *obj.Spec.Parallelism
 		}
+		completions = ratioCell(int64(obj.Status.Succeeded), 1)
 		if parallelism > 1 {
-			completions = fmt.Sprintf("%d/1 of %d", obj.Status.Succeeded, parallelism)
-		} else {
-			completions = fmt.Sprintf("%d/1", obj.Status.Succeeded)
+			completions.Display = fmt.Sprintf("%d/1 of %d", obj.Status.Succeeded, parallelism)
 		}
 	}
-	var jobDuration string
+	var jobDuration Cell
 	switch {
 	case obj.Status.StartTime == nil:
 	case obj.Status.CompletionTime == nil:
-		jobDuration = duration.HumanDuration(time.Since(obj.Status.StartTime.Time))
+		d := time.Since(obj.Status.StartTime.Time)
+		jobDuration = Cell{Raw: d, Display: duration.HumanDuration(d), since: obj.Status.StartTime.Time}
 	default:
-		jobDuration = duration.HumanDuration(obj.Status.CompletionTime.Sub(obj.Status.StartTime.Time))
+		d := obj.Status.CompletionTime.Sub(obj.Status.StartTime.Time)
+		jobDuration = Cell{Raw: d, Display: duration.HumanDuration(d)}
 	}
 
 	row["Name"] = obj.Name
 	row["Completions"] = completions
 	row["Duration"] = jobDuration
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	names, images := layoutContainerCells(obj.Spec.Template.Spec.Containers)
 	row["Containers"] = names
@@ -69,3 +84,29 @@ func (p JobPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 
 	return row, nil
 }
+
+func (_ JobPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*batch.Job)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return jobHealth(obj)
+}
+
+func jobHealth(obj *batch.Job) Health {
+	for _, c := range obj.Status.Conditions {
+		if c.Status != core.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batch.JobFailed:
+			return Health{Level: HealthError, Reason: c.Reason}
+		case batch.JobComplete:
+			return Health{Level: HealthOK, Reason: "Complete"}
+		}
+	}
+	if obj.Status.Active > 0 {
+		return Health{Level: HealthProgressing, Reason: "Running"}
+	}
+	return Health{Level: HealthProgressing, Reason: "Pending"}
+}
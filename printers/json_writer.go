@@ -0,0 +1,139 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"sigs.k8s.io/yaml"
+)
+
+// RowSchemaVersion is the version of the RowDocument schema. It changes
+// whenever a field is removed or changes meaning; new columns or kinds do
+// not change it.
+const RowSchemaVersion = "v1"
+
+// RowDocument is the structured form of a converted row, written by
+// JSONWriter and described by RowJSONSchema.
+type RowDocument struct {
+	// SchemaVersion is always RowSchemaVersion.
+	SchemaVersion string `json:"schemaVersion"`
+	APIVersion    string `json:"apiVersion"`
+	Kind          string `json:"kind"`
+	Namespace     string `json:"namespace,omitempty"`
+	Name          string `json:"name"`
+	Health        Health `json:"health"`
+	// Columns holds a RowColumn per column definition, keyed by column name.
+	Columns map[string]RowColumn `json:"columns"`
+	// SubRows holds the sub-rows of the row by name, e.g. the containers of
+	// a pod converted with PodPrinter.ContainerDetails under
+	// ContainerDetailsKey.
+	SubRows map[string][]map[string]RowColumn `json:"subRows,omitempty"`
+}
+
+// RowColumn is a cell of a RowDocument. Raw is null for missing values,
+// times are formatted as RFC 3339 and durations are given in seconds.
+type RowColumn struct {
+	Raw     interface{} `json:"raw"`
+	Display string      `json:"display"`
+}
+
+// NewRowDocuments returns a RowDocument per row of the given tables.
+func NewRowDocuments(tables ...Table) []RowDocument {
+	var docs []RowDocument
+	for _, t := range tables {
+		apiVersion, kind := t.GVK.ToAPIVersionAndKind()
+		for _, row := range t.Rows {
+			ns, name := row.namespaceName()
+			doc := RowDocument{
+				SchemaVersion: RowSchemaVersion,
+				APIVersion:    apiVersion,
+				Kind:          kind,
+				Namespace:     ns,
+				Name:          name,
+				Health:        row.Health,
+				Columns:       make(map[string]RowColumn, len(t.Columns)),
+			}
+			for _, col := range t.Columns {
+				v := row.Cells[col.Name]
+				doc.Columns[col.Name] = RowColumn{Raw: rawJSON(RawValue(v)), Display: DisplayValue(v)}
+			}
+			keys, subs := row.subTables()
+			for i, sub := range subs {
+				if doc.SubRows == nil {
+					doc.SubRows = map[string][]map[string]RowColumn{}
+				}
+				rows := make([]map[string]RowColumn, len(sub.Rows))
+				for j, cells := range sub.Rows {
+					rows[j] = make(map[string]RowColumn, len(sub.Columns))
+					for _, col := range sub.Columns {
+						v := cells[col.Name]
+						rows[j][col.Name] = RowColumn{Raw: rawJSON(RawValue(v)), Display: DisplayValue(v)}
+					}
+				}
+				doc.SubRows[keys[i]] = rows
+			}
+			docs = append(docs, doc)
+		}
+	}
+	return docs
+}
+
+func rawJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case time.Duration:
+		return int64(v.Seconds())
+	}
+	return v
+}
+
+// JSONWriter writes a RowDocument per row, as newline-delimited JSON or as
+// a stream of YAML documents. It only writes tables of registered kinds
+// with converted cells, whose rows are described by RowJSONSchema.
+type JSONWriter struct {
+	YAML bool
+}
+
+func (w JSONWriter) Write(out io.Writer, tables ...Table) error {
+	for _, t := range tables {
+		if _, ok := printers[t.GVK]; !ok {
+			return fmt.Errorf("no row schema for %v: derived tables, e.g. grouped rows, cannot be written as JSON or YAML", t.GVK)
+		}
+		for _, row := range t.Rows {
+			for name, v := range row.Cells {
+				if _, ok := v.(CellChange); ok {
+					return fmt.Errorf("no row schema for the changed %s cells of a diff: diffs cannot be written as JSON or YAML", name)
+				}
+			}
+		}
+	}
+	for i, doc := range NewRowDocuments(tables...) {
+		if !w.YAML {
+			data, err := json.Marshal(doc)
+			if err != nil {
+				return err
+			}
+			if _, err := out.Write(append(data, '\n')); err != nil {
+				return err
+			}
+			continue
+		}
+
+		data, err := yaml.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(out, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package printers
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// isJSONPath reports whether spec is a JSONPath expression like {.spec.nodeName}
+// or .spec.nodeName, as opposed to the name of a converter column.
+func isJSONPath(spec string) bool {
+	return strings.HasPrefix(spec, "{") || strings.HasPrefix(spec, ".")
+}
+
+// parseJSONPath parses a JSONPath expression in the relaxed syntax accepted
+// by kubectl, i.e. the surrounding braces are optional.
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+	jp := jsonpath.New("column").AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %q: %w", expr, err)
+	}
+	return jp, nil
+}
+
+// evalJSONPath evaluates jp against obj. A single result is returned as is,
+// multiple results are joined by "," and nil is returned if nothing matched.
+func evalJSONPath(jp *jsonpath.JSONPath, obj runtime.Object) (interface{}, error) {
+	if obj == nil {
+		return nil, nil
+	}
+	data, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	results, err := jp.FindResults(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []interface{}
+	for _, arr := range results {
+		for _, val := range arr {
+			values = append(values, val.Interface())
+		}
+	}
+	switch len(values) {
+	case 0:
+		return nil, nil
+	case 1:
+		return values[0], nil
+	}
+	strs := make([]string, len(values))
+	for i, v := range values {
+		strs[i] = fmt.Sprintf("%v", v)
+	}
+	return strings.Join(strs, ","), nil
+}
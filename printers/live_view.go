@@ -0,0 +1,433 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/term"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const (
+	ansiReverse = "\x1b[7m"
+
+	keyCtrlC     = 3
+	keyBackspace = 8
+	keyEnter     = 13
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+// LiveView is a full-screen table that is updated in place as watch events
+// arrive, like top. It keeps a row per object, highlights cells that changed
+// recently and can be sorted and filtered from the keyboard:
+//
+//	< >  sort by the previous or next column
+//	r    reverse the sort order
+//	/    filter rows by text, Enter to apply, Esc to clear
+//	q    quit
+type LiveView struct {
+	Priority int32
+	// HighlightFor is how long changed cells stay highlighted. It defaults
+	// to 3 seconds.
+	HighlightFor time.Duration
+	// Color controls whether the status line, changed cells and cells of
+	// unhealthy rows are highlighted. Run resolves ColorAuto for its output.
+	Color ColorMode
+
+	color   bool
+	rows    map[string]*liveRow
+	order   []string
+	columns []string
+	sortBy  string
+	reverse bool
+	filter  string
+	editing bool
+	status  string
+}
+
+type liveRow struct {
+	object    runtime.Object
+	gvk       schema.GroupVersionKind
+	converter ColumnConverter
+	cells     map[string]interface{}
+	health    Health
+	changed   map[string]time.Time
+}
+
+// redrawDelay is how long Run collects events before it draws them, so a
+// burst of events, like the initial list of a watch, is drawn once.
+const redrawDelay = 50 * time.Millisecond
+
+func NewLiveView(priority int32) *LiveView {
+	return &LiveView{Priority: priority}
+}
+
+// Update applies a watch event. Rows of added or modified objects are
+// converted right away, so conversion errors are returned here rather than
+// when rendering.
+func (v *LiveView) Update(e watch.Event, now time.Time) error {
+	switch e.Type {
+	case watch.Bookmark:
+		return nil
+	case watch.Error:
+		return watchError(e.Object)
+	}
+
+	key, err := liveKey(e.Object)
+	if err != nil {
+		return err
+	}
+	if v.rows == nil {
+		v.rows = map[string]*liveRow{}
+	}
+
+	if e.Type == watch.Deleted {
+		if _, ok := v.rows[key]; ok {
+			delete(v.rows, key)
+			for i, k := range v.order {
+				if k == key {
+					v.order = append(v.order[:i], v.order[i+1:]...)
+					break
+				}
+			}
+		}
+		return nil
+	}
+
+	gvk := e.Object.GetObjectKind().GroupVersionKind()
+	c, err := converterFor(e.Object, gvk)
+	if err != nil {
+		return err
+	}
+	converted, err := convert(c, e.Object, v.Priority)
+	if err != nil {
+		return err
+	}
+
+	row, ok := v.rows[key]
+	if !ok {
+		v.rows[key] = &liveRow{object: e.Object, gvk: gvk, converter: c, cells: converted.Cells, health: converted.Health, changed: map[string]time.Time{}}
+		v.order = append(v.order, key)
+		return nil
+	}
+	// compare the data of cells, so cells like Age that change with time
+	// alone are not highlighted
+	for name, cell := range converted.Cells {
+		if !sameValue(cell, row.cells[name]) {
+			row.changed[name] = now
+		}
+	}
+	row.object = e.Object
+	row.gvk = gvk
+	row.converter = c
+	row.cells = converted.Cells
+	row.health = converted.Health
+	return nil
+}
+
+// Refresh converts the rows again, so cells like Age stay current. Cells
+// that change are not highlighted, since their objects did not change.
+func (v *LiveView) Refresh() error {
+	for _, key := range v.order {
+		row := v.rows[key]
+		converted, err := convert(row.converter, row.object, v.Priority)
+		if err != nil {
+			return err
+		}
+		row.cells = converted.Cells
+		row.health = converted.Health
+	}
+	return nil
+}
+
+// liveKey identifies the object of a row by its UID, or by its kind,
+// namespace and name if it has none.
+func liveKey(o runtime.Object) (string, error) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return "", err
+	}
+	if uid := m.GetUID(); uid != "" {
+		return string(uid), nil
+	}
+	return fmt.Sprintf("%v/%s/%s", o.GetObjectKind().GroupVersionKind(), m.GetNamespace(), m.GetName()), nil
+}
+
+// HandleKey applies a key press and reports whether the view should quit.
+func (v *LiveView) HandleKey(key byte) bool {
+	if v.editing {
+		switch key {
+		case keyEnter:
+			v.editing = false
+		case keyEscape:
+			v.editing = false
+			v.filter = ""
+		case keyBackspace, keyDelete:
+			if len(v.filter) > 0 {
+				v.filter = v.filter[:len(v.filter)-1]
+			}
+		case keyCtrlC:
+			return true
+		default:
+			if key >= ' ' {
+				v.filter += string(key)
+			}
+		}
+		return false
+	}
+
+	switch key {
+	case 'q', keyCtrlC:
+		return true
+	case '<', '>':
+		v.moveSort(key == '>')
+	case 'r':
+		v.reverse = !v.reverse
+	case '/':
+		v.editing = true
+	case keyEscape:
+		v.filter = ""
+	}
+	return false
+}
+
+func (v *LiveView) moveSort(next bool) {
+	if len(v.columns) == 0 {
+		return
+	}
+	i := -1
+	for j, name := range v.columns {
+		if name == v.sortBy {
+			i = j
+		}
+	}
+	switch {
+	case next:
+		i++
+	case i < 0:
+		i = len(v.columns) - 1
+	default:
+		i--
+	}
+	if i < 0 || i >= len(v.columns) {
+		// wrap around to the original order
+		v.sortBy = ""
+		return
+	}
+	v.sortBy = v.columns[i]
+}
+
+// Render returns the screen for the given terminal size. It shows the rows
+// as converted by Update and Refresh, without converting them again.
+func (v *LiveView) Render(now time.Time, width, height int) string {
+	var tables []Table
+	var converters []ColumnConverter
+	index := map[schema.GroupVersionKind]int{}
+	for _, key := range v.order {
+		row := v.rows[key]
+		i, ok := index[row.gvk]
+		if !ok {
+			i = len(tables)
+			index[row.gvk] = i
+			tables = append(tables, Table{GVK: row.gvk})
+			converters = append(converters, row.converter)
+		}
+		tables[i].Rows = append(tables[i].Rows, Row{Object: row.object, Cells: row.cells, Health: row.health})
+	}
+	for i := range tables {
+		tables[i].Columns = tableColumns(converters[i], tables[i].Rows, v.Priority)
+	}
+	status := v.status
+	var t Table
+	if len(tables) > 0 {
+		t = mergeTables(tables)
+	}
+
+	v.columns = v.columns[:0]
+	for _, col := range t.Columns {
+		v.columns = append(v.columns, col.Name)
+	}
+	if v.filter != "" {
+		t.Rows = filterRows(t, v.filter)
+	}
+	if v.sortBy != "" {
+		if err := SortRows(t, v.sortBy, v.reverse); err != nil {
+			status = err.Error()
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\x1b[H")
+	if v.color {
+		sb.WriteString(ansiReverse + v.statusLine(len(t.Rows), status, width) + ansiReset)
+	} else {
+		sb.WriteString(v.statusLine(len(t.Rows), status, width))
+	}
+	sb.WriteString("\x1b[K\r\n")
+	highlightFor := v.HighlightFor
+	if highlightFor == 0 {
+		highlightFor = 3 * time.Second
+	}
+	if len(t.Columns) > 0 {
+		lines := [][]string{headerLine(t.Columns)}
+		colors := [][]string{make([]string, len(t.Columns))}
+		for _, row := range t.Rows {
+			line, lineColors := rowLine(t, row, false, v.color)
+			if key, err := liveKey(row.Object); err == nil && v.color {
+				for j, col := range t.Columns {
+					if at, ok := v.rows[key].changed[col.Name]; ok && now.Sub(at) < highlightFor {
+						lineColors[j] = ansiReverse + lineColors[j]
+					}
+				}
+			}
+			lines = append(lines, line)
+			colors = append(colors, lineColors)
+		}
+		widths := columnWidths(lines)
+		for j, line := range lines {
+			if j >= height-1 {
+				break
+			}
+			sb.WriteString(strings.TrimSuffix(formatLine(line, colors[j], widths), "\n"))
+			sb.WriteString("\x1b[K\r\n")
+		}
+	}
+	sb.WriteString("\x1b[J")
+	return sb.String()
+}
+
+func (v *LiveView) statusLine(rows int, status string, width int) string {
+	parts := []string{fmt.Sprintf("%d rows", rows)}
+	if v.sortBy != "" {
+		order := "asc"
+		if v.reverse {
+			order = "desc"
+		}
+		parts = append(parts, fmt.Sprintf("sort: %s %s", v.sortBy, order))
+	}
+	if v.editing {
+		parts = append(parts, "filter: "+v.filter+"_")
+	} else if v.filter != "" {
+		parts = append(parts, "filter: "+v.filter)
+	}
+	if status != "" {
+		parts = append(parts, status)
+	}
+	parts = append(parts, "q quit, < > sort, r reverse, / filter")
+
+	line := strings.Join(parts, " | ")
+	if n := len([]rune(line)); n < width {
+		line += strings.Repeat(" ", width-n)
+	} else if n > width && width > 0 {
+		line = string([]rune(line)[:width])
+	}
+	return line
+}
+
+// filterRows returns the rows of t with a cell that contains text, ignoring
+// case.
+func filterRows(t Table, text string) []Row {
+	text = strings.ToLower(text)
+	var rows []Row
+	for _, row := range t.Rows {
+		for _, col := range t.Columns {
+			if strings.Contains(strings.ToLower(DisplayValue(row.Cells[col.Name])), text) {
+				rows = append(rows, row)
+				break
+			}
+		}
+	}
+	return rows
+}
+
+// Run shows the view on out until q is pressed. It reads key presses from
+// keys, which is put into raw mode if it is a terminal, and applies events
+// until the channel is closed. Errors of the event stream are shown in the
+// status line, and stay there when the stream is closed.
+func (v *LiveView) Run(events <-chan watch.Event, keys, out *os.File) error {
+	if term.IsTerminal(int(keys.Fd())) {
+		state, err := term.MakeRaw(int(keys.Fd()))
+		if err != nil {
+			return err
+		}
+		defer term.Restore(int(keys.Fd()), state)
+	}
+	// switch to the alternate screen, hide the cursor and disable line wrap
+	if _, err := io.WriteString(out, "\x1b[?1049h\x1b[?25l\x1b[?7l"); err != nil {
+		return err
+	}
+	defer io.WriteString(out, "\x1b[?7h\x1b[?25h\x1b[?1049l")
+	v.color = v.Color.enabled(out)
+
+	keyc := make(chan byte)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if n, err := keys.Read(buf); err != nil {
+				close(keyc)
+				return
+			} else if n > 0 {
+				keyc <- buf[0]
+			}
+		}
+	}()
+
+	draw := func() error {
+		width, height, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			width, height = 80, 24
+		}
+		_, err = io.WriteString(out, v.Render(time.Now(), width, height))
+		return err
+	}
+	if err := draw(); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	// set while events wait to be drawn
+	var redraw <-chan time.Time
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				events = nil
+				if v.status != "" {
+					v.status = "watch closed: " + v.status
+				} else {
+					v.status = "watch closed"
+				}
+			} else {
+				v.status = ""
+				if err := v.Update(e, time.Now()); err != nil {
+					v.status = err.Error()
+				}
+			}
+			if redraw == nil {
+				redraw = time.After(redrawDelay)
+			}
+			continue
+		case <-redraw:
+			redraw = nil
+		case key, ok := <-keyc:
+			if !ok || v.HandleKey(key) {
+				return nil
+			}
+		case <-ticker.C:
+			if err := v.Refresh(); err != nil {
+				v.status = err.Error()
+			}
+		}
+		if err := draw(); err != nil {
+			return err
+		}
+	}
+}
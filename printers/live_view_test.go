@@ -0,0 +1,106 @@
+package printers
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestLiveViewRenderColor(t *testing.T) {
+	now := time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)
+	pod := func(phase core.PodPhase) *core.Pod {
+		return &core.Pod{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{Name: "job-x7k2", Namespace: "batch", UID: "7f1c"},
+			Status:     core.PodStatus{Phase: phase},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		color bool
+		want  []string
+		not   []string
+	}{
+		{name: "colored", color: true, want: []string{ansiReverse, ansiRed}},
+		{name: "plain", not: []string{ansiReverse, ansiRed, ansiReset}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewLiveView(PriorityDefault)
+			v.color = tt.color
+			for _, phase := range []core.PodPhase{core.PodRunning, core.PodFailed} {
+				if err := v.Update(watch.Event{Type: watch.Modified, Object: pod(phase)}, now); err != nil {
+					t.Fatal(err)
+				}
+			}
+			screen := v.Render(now.Add(time.Second), 100, 10)
+			if !strings.Contains(screen, "job-x7k2") {
+				t.Fatalf("row missing from screen %q", screen)
+			}
+			for _, s := range tt.want {
+				if !strings.Contains(screen, s) {
+					t.Errorf("screen %q does not contain %q", screen, s)
+				}
+			}
+			for _, s := range tt.not {
+				if strings.Contains(screen, s) {
+					t.Errorf("screen %q contains %q", screen, s)
+				}
+			}
+		})
+	}
+}
+
+func TestLiveViewRunKeepsErrorWhenClosed(t *testing.T) {
+	keysR, keysW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer keysR.Close()
+	defer keysW.Close()
+	outR, outW, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer outR.Close()
+
+	events := make(chan watch.Event, 1)
+	events <- watch.Event{Type: watch.Error, Object: &metav1.Status{Status: metav1.StatusFailure, Message: "unexpected EOF"}}
+	close(events)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- NewLiveView(PriorityDefault).Run(events, keysR, outW)
+		outW.Close()
+	}()
+
+	const want = "watch closed: unexpected EOF"
+	var found bool
+	scanner := bufio.NewScanner(outR)
+	for scanner.Scan() {
+		if strings.Contains(scanner.Text(), want) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("status line %q was never drawn", want)
+	}
+	if _, err := keysW.Write([]byte("q")); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for scanner.Scan() {
+		}
+	}()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
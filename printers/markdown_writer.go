@@ -0,0 +1,67 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// MarkdownWriter writes tables as GitHub-flavored Markdown.
+type MarkdownWriter struct {
+	// GroupByKind writes a separate table with a heading per kind. Otherwise
+	// all kinds are written as one table with the union of their columns.
+	GroupByKind bool
+}
+
+// markdownEscaper escapes text for table cells. Markdown renderers pass
+// HTML through, so placeholders like <none> are escaped to stay visible.
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "&", "&amp;", "<", "&lt;", ">", "&gt;", "\r\n", "<br>", "\n", "<br>")
+
+func (w MarkdownWriter) Write(out io.Writer, tables ...Table) error {
+	if len(tables) == 0 {
+		return nil
+	}
+	if !w.GroupByKind {
+		tables = []Table{mergeTables(tables)}
+	}
+
+	var sb strings.Builder
+	for i, t := range tables {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		if w.GroupByKind {
+			fmt.Fprintf(&sb, "### %s\n\n", t.GVK.GroupKind())
+		}
+
+		sb.WriteString("|")
+		for _, col := range t.Columns {
+			fmt.Fprintf(&sb, " %s |", markdownEscaper.Replace(col.Name))
+		}
+		sb.WriteString("\n|")
+		for _, col := range t.Columns {
+			if col.Type == "integer" || col.Type == "number" {
+				sb.WriteString(" ---: |")
+			} else {
+				sb.WriteString(" --- |")
+			}
+		}
+		sb.WriteString("\n")
+
+		for _, row := range t.Rows {
+			sb.WriteString("|")
+			for _, col := range t.Columns {
+				v, ok := row.Cells[col.Name]
+				text := ""
+				if ok {
+					text = DisplayValue(v)
+				}
+				fmt.Fprintf(&sb, " %s |", markdownEscaper.Replace(text))
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	_, err := io.WriteString(out, sb.String())
+	return err
+}
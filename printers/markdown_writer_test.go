@@ -0,0 +1,92 @@
+package printers
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestMarkdownWriter(t *testing.T) {
+	deployments := Table{
+		GVK: schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Columns: []metav1.TableColumnDefinition{
+			{Name: "Name", Format: "name"}, {Name: "Selector"}, {Name: "Replicas", Type: "integer"},
+		},
+		Rows: []Row{
+			{Cells: map[string]interface{}{"Name": "web", "Selector": "app=web,tier in (a|b)", "Replicas": int64(3)}},
+			{Cells: map[string]interface{}{"Name": "api", "Selector": "<none>", "Replicas": int64(0)}},
+		},
+	}
+	events := Table{
+		GVK: schema.GroupVersionKind{Version: "v1", Kind: "Event"},
+		Columns: []metav1.TableColumnDefinition{
+			{Name: "Name", Format: "name"}, {Name: "Message"},
+		},
+		Rows: []Row{
+			{Cells: map[string]interface{}{"Name": "e1", "Message": "Back-off & retry\r\nexit code > 0"}},
+			{Cells: map[string]interface{}{"Name": `x\y`, "Message": "first\nsecond"}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		w      MarkdownWriter
+		tables []Table
+		want   string
+	}{
+		{
+			name:   "pipes and placeholders",
+			tables: []Table{deployments},
+			want: "| Name | Selector | Replicas |\n" +
+				"| --- | --- | ---: |\n" +
+				"| web | app=web,tier in (a\\|b) | 3 |\n" +
+				"| api | &lt;none&gt; | 0 |\n",
+		},
+		{
+			name:   "ampersands, backslashes and newlines",
+			tables: []Table{events},
+			want: "| Name | Message |\n" +
+				"| --- | --- |\n" +
+				"| e1 | Back-off &amp; retry<br>exit code &gt; 0 |\n" +
+				"| x\\\\y | first<br>second |\n",
+		},
+		{
+			name:   "union of several kinds",
+			tables: []Table{events, deployments},
+			want: "| Name | Message | Selector | Replicas |\n" +
+				"| --- | --- | --- | ---: |\n" +
+				"| event/e1 | Back-off &amp; retry<br>exit code &gt; 0 |  |  |\n" +
+				"| event/x\\\\y | first<br>second |  |  |\n" +
+				"| deployment.apps/web |  | app=web,tier in (a\\|b) | 3 |\n" +
+				"| deployment.apps/api |  | &lt;none&gt; | 0 |\n",
+		},
+		{
+			name:   "a heading per kind",
+			w:      MarkdownWriter{GroupByKind: true},
+			tables: []Table{deployments, events},
+			want: "### Deployment.apps\n\n" +
+				"| Name | Selector | Replicas |\n" +
+				"| --- | --- | ---: |\n" +
+				"| web | app=web,tier in (a\\|b) | 3 |\n" +
+				"| api | &lt;none&gt; | 0 |\n" +
+				"\n### Event\n\n" +
+				"| Name | Message |\n" +
+				"| --- | --- |\n" +
+				"| e1 | Back-off &amp; retry<br>exit code &gt; 0 |\n" +
+				"| x\\\\y | first<br>second |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.w.Write(&buf, tt.tables...); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func init() {
+	Register(NodePrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L241-L252
+
+const (
+	// labelNodeRolePrefix is a label prefix for node roles
+	// It's copied over to here until it's merged in core: https://github.com/kubernetes/kubernetes/pull/39112
+	labelNodeRolePrefix = "node-role.kubernetes.io/"
+
+	// nodeLabelRole specifies the role of a node
+	nodeLabelRole = "kubernetes.io/role"
+)
+
+type NodePrinter struct{}
+
+var _ ColumnConverter = NodePrinter{}
+var _ HealthConverter = NodePrinter{}
+
+func (_ NodePrinter) GVK() schema.GroupVersionKind {
+	return core.SchemeGroupVersion.WithKind("Node")
+}
+
+func (_ NodePrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Status", Type: "string", Description: "The status of the node"},
+		{Name: "Roles", Type: "string", Description: "The roles of the node"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Version", Type: "string", Description: core.NodeSystemInfo{}.SwaggerDoc()["kubeletVersion"]},
+		{Name: "Internal-IP", Type: "string", Priority: 1, Description: core.NodeStatus{}.SwaggerDoc()["addresses"]},
+		{Name: "External-IP", Type: "string", Priority: 1, Description: core.NodeStatus{}.SwaggerDoc()["addresses"]},
+		{Name: "OS-Image", Type: "string", Priority: 1, Description: core.NodeSystemInfo{}.SwaggerDoc()["osImage"]},
+		{Name: "Kernel-Version", Type: "string", Priority: 1, Description: core.NodeSystemInfo{}.SwaggerDoc()["kernelVersion"]},
+		{Name: "Container-Runtime", Type: "string", Priority: 1, Description: core.NodeSystemInfo{}.SwaggerDoc()["containerRuntimeVersion"]},
+	}
+}
+
+func (p NodePrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*core.Node)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	status := nodeStatus(obj)
+	roles := listCell(findNodeRoles(obj), "<none>")
+
+	row["Name"] = obj.Name
+	row["Status"] = strings.Join(status, ",")
+	row["Roles"] = roles
+	row["Age"] = timestampCell(obj.CreationTimestamp)
+	row["Version"] = obj.Status.NodeInfo.KubeletVersion
+
+	row["Internal-IP"] = optionalCell(getNodeAddress(obj, core.NodeInternalIP), "<none>")
+	row["External-IP"] = optionalCell(getNodeAddress(obj, core.NodeExternalIP), "<none>")
+	row["OS-Image"] = optionalCell(obj.Status.NodeInfo.OSImage, "<unknown>")
+	row["Kernel-Version"] = optionalCell(obj.Status.NodeInfo.KernelVersion, "<unknown>")
+	row["Container-Runtime"] = optionalCell(obj.Status.NodeInfo.ContainerRuntimeVersion, "<unknown>")
+
+	return row, nil
+}
+
+func (_ NodePrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*core.Node)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return nodeHealth(nodeStatus(obj))
+}
+
+// nodeStatus returns the conditions kubectl prints in the Status column,
+// e.g. Ready,SchedulingDisabled.
+func nodeStatus(obj *core.Node) []string {
+	conditionMap := make(map[core.NodeConditionType]*core.NodeCondition)
+	NodeAllConditions := []core.NodeConditionType{core.NodeReady}
+	for i := range obj.Status.Conditions {
+		cond := obj.Status.Conditions[i]
+		conditionMap[cond.Type] = &cond
+	}
+	var status []string
+	for _, validCondition := range NodeAllConditions {
+		if condition, ok := conditionMap[validCondition]; ok {
+			if condition.Status == core.ConditionTrue {
+				status = append(status, string(condition.Type))
+			} else {
+				status = append(status, "Not"+string(condition.Type))
+			}
+		}
+	}
+	if len(status) == 0 {
+		status = append(status, "Unknown")
+	}
+	if obj.Spec.Unschedulable {
+		status = append(status, "SchedulingDisabled")
+	}
+	return status
+}
+
+// Returns the first address of the given type or "" if none is found.
+func getNodeAddress(node *core.Node, addressType core.NodeAddressType) string {
+	for _, address := range node.Status.Addresses {
+		if address.Type == addressType {
+			return address.Address
+		}
+	}
+
+	return ""
+}
+
+// findNodeRoles returns the roles of a given node.
+// The roles are determined by looking for:
+// * a node-role.kubernetes.io/<role>="" label
+// * a kubernetes.io/role="<role>" label
+func findNodeRoles(node *core.Node) []string {
+	roles := sets.NewString()
+	for k, v := range node.Labels {
+		switch {
+		case strings.HasPrefix(k, labelNodeRolePrefix):
+			if role := strings.TrimPrefix(k, labelNodeRolePrefix); len(role) > 0 {
+				roles.Insert(role)
+			}
+
+		case k == nodeLabelRole && v != "":
+			roles.Insert(v)
+		}
+	}
+	return roles.List()
+}
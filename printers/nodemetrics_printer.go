@@ -0,0 +1,81 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	metrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func init() {
+	Register(NodeMetricsPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubectl/blob/v0.21.0/pkg/metricsutil/metrics_printer.go
+
+// NodeMetricsPrinter converts NodeMetrics like kubectl top node. Percentages
+// of the allocatable resources are computed for the nodes found in Nodes,
+// see NewUsageTables.
+type NodeMetricsPrinter struct {
+	Nodes map[string]*core.Node
+}
+
+var _ ColumnConverter = NodeMetricsPrinter{}
+var _ HealthConverter = NodeMetricsPrinter{}
+
+func (_ NodeMetricsPrinter) GVK() schema.GroupVersionKind {
+	return metrics.SchemeGroupVersion.WithKind("NodeMetrics")
+}
+
+func (_ NodeMetricsPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "CPU(cores)", Type: "integer", Description: "The CPU usage of the node, in millicores."},
+		{Name: "CPU%", Type: "number", Description: "The CPU usage in percent of the allocatable CPU of the node."},
+		{Name: "Memory(bytes)", Type: "integer", Description: "The memory working set of the node, in bytes."},
+		{Name: "Memory%", Type: "number", Description: "The memory usage in percent of the allocatable memory of the node."},
+		{Name: "Window", Type: "string", Priority: PriorityExtended, Description: "The length of the interval the usage was measured over."},
+	}
+}
+
+func (p NodeMetricsPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*metrics.NodeMetrics)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	row["Name"] = obj.Name
+	row["CPU(cores)"] = cpuCell(obj.Usage)
+	row["Memory(bytes)"] = memoryCell(obj.Usage)
+	row["Window"] = Cell{Raw: obj.Window.Duration, Display: obj.Window.Duration.String()}
+
+	node, found := p.Nodes[obj.Name]
+	if !found {
+		row["CPU%"], row["Memory%"] = Cell{Display: "<unknown>"}, Cell{Display: "<unknown>"}
+		return row, nil
+	}
+
+	row["CPU%"] = usagePercentCell(obj.Usage, node.Status.Allocatable, core.ResourceCPU)
+	row["Memory%"] = usagePercentCell(obj.Usage, node.Status.Allocatable, core.ResourceMemory)
+
+	return row, nil
+}
+
+// Health is Unknown for nodes that are not in Nodes.
+func (p NodeMetricsPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*metrics.NodeMetrics)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	node, found := p.Nodes[obj.Name]
+	if !found {
+		return Health{Level: HealthUnknown}
+	}
+	return usageHealth(obj.Usage, node.Status.Allocatable)
+}
@@ -0,0 +1,57 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(PartialObjectMetadataPrinter{})
+}
+
+// PartialObjectMetadataPrinter converts the objects returned by metadata-only
+// clients and informers. Since those objects usually carry the GVK of the
+// resource they were read from, Convert dispatches them here by Go type.
+type PartialObjectMetadataPrinter struct {
+	// ShowLabels adds a Labels column, like kubectl get --show-labels.
+	ShowLabels bool
+}
+
+var _ ColumnConverter = PartialObjectMetadataPrinter{}
+
+func (_ PartialObjectMetadataPrinter) GVK() schema.GroupVersionKind {
+	return metav1.SchemeGroupVersion.WithKind("PartialObjectMetadata")
+}
+
+func (p PartialObjectMetadataPrinter) Columns() []metav1.TableColumnDefinition {
+	columns := []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+	}
+	if p.ShowLabels {
+		columns = append(columns, metav1.TableColumnDefinition{Name: "Labels", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["labels"]})
+	}
+	return columns
+}
+
+func (p PartialObjectMetadataPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*metav1.PartialObjectMetadata)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	row["Name"] = obj.Name
+	row["Age"] = timestampCell(obj.CreationTimestamp)
+	if p.ShowLabels {
+		row["Labels"] = labels.FormatLabels(obj.Labels)
+	}
+
+	return row, nil
+}
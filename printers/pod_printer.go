@@ -3,10 +3,13 @@ package printers
 import (
 	"fmt"
 	"reflect"
+	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -16,14 +19,49 @@ func init() {
 
 // ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L89-L101
 
-type PodPrinter struct{}
+type PodPrinter struct {
+	// ContainerDetails adds a SubTable cell, keyed by ContainerDetailsKey,
+	// that breaks the pod down into one sub-row per init, regular and
+	// ephemeral container.
+	ContainerDetails bool
+}
+
+// ContainerDetailsKey is the key of the container sub-rows of a pod
+// converted with PodPrinter.ContainerDetails.
+const ContainerDetailsKey = "Container Details"
 
 var _ ColumnConverter = PodPrinter{}
+var _ HealthConverter = PodPrinter{}
 
 func (_ PodPrinter) GVK() schema.GroupVersionKind {
 	return core.SchemeGroupVersion.WithKind("Pod")
 }
 
+func (_ PodPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Ready", Type: "string", Description: "The aggregate readiness state of this pod for accepting traffic."},
+		{Name: "Status", Type: "string", Description: "The aggregate status of the containers in this pod."},
+		{Name: "Restarts", Type: "integer", Description: "The number of times the containers in this pod have been restarted."},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "IP", Type: "string", Priority: 1, Description: core.PodStatus{}.SwaggerDoc()["podIP"]},
+		{Name: "Node", Type: "string", Priority: 1, Description: core.PodSpec{}.SwaggerDoc()["nodeName"]},
+		{Name: "Nominated Node", Type: "string", Priority: 1, Description: core.PodStatus{}.SwaggerDoc()["nominatedNodeName"]},
+		{Name: "Readiness Gates", Type: "string", Priority: 1, Description: core.PodSpec{}.SwaggerDoc()["readinessGates"]},
+		{Name: "QoS Class", Type: "string", Priority: PriorityExtended, Description: core.PodStatus{}.SwaggerDoc()["qosClass"]},
+		{Name: "Priority", Type: "integer", Priority: PriorityExtended, Description: core.PodSpec{}.SwaggerDoc()["priority"]},
+		{Name: "Priority Class", Type: "string", Priority: PriorityExtended, Description: core.PodSpec{}.SwaggerDoc()["priorityClassName"]},
+		{Name: "Service Account", Type: "string", Priority: PriorityExtended, Description: core.PodSpec{}.SwaggerDoc()["serviceAccountName"]},
+		{Name: "Controlled By", Type: "string", Priority: PriorityExtended, Description: "The controlling owner of this pod."},
+		{Name: "Host Network", Type: "boolean", Priority: PriorityExtended, Description: core.PodSpec{}.SwaggerDoc()["hostNetwork"]},
+		{Name: "CPU Requests", Type: "string", Priority: PriorityExtended, Description: "The total CPU requested by the containers in this pod."},
+		{Name: "CPU Limits", Type: "string", Priority: PriorityExtended, Description: "The total CPU limit of the containers in this pod."},
+		{Name: "Memory Requests", Type: "string", Priority: PriorityExtended, Description: "The total memory requested by the containers in this pod."},
+		{Name: "Memory Limits", Type: "string", Priority: PriorityExtended, Description: "The total memory limit of the containers in this pod."},
+		{Name: "Host IP", Type: "string", Priority: PriorityExtended, Description: core.PodStatus{}.SwaggerDoc()["hostIP"]},
+	}
+}
+
 /*
 	"name": "Name",
 	"name": "Ready",
@@ -41,8 +79,119 @@ func (p PodPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
 	}
 
-	restarts := 0
+	reason, readyContainers, restarts, lastRestartDate := podStatus(pod)
 	totalContainers := len(pod.Spec.Containers)
+
+	row := map[string]interface{}{}
+
+	/*
+		"name": "Name",
+		"name": "Ready",
+		"name": "Status",
+		"name": "Restarts",
+		"name": "Age",
+	*/
+	row["Name"] = pod.Name
+	row["Ready"] = ratioCell(int64(readyContainers), int64(totalContainers))
+	row["Status"] = reason
+	row["Restarts"] = PodRestarts{Count: int64(restarts), LastRestart: lastRestartDate}
+	row["Age"] = timestampCell(pod.CreationTimestamp)
+
+	nodeName := pod.Spec.NodeName
+	nominatedNodeName := pod.Status.NominatedNodeName
+	podIP := ""
+	if len(pod.Status.PodIPs) > 0 {
+		podIP = pod.Status.PodIPs[0].IP
+	}
+
+	readinessGates := Cell{Display: "<none>"}
+	if len(pod.Spec.ReadinessGates) > 0 {
+		trueConditions := 0
+		for _, readinessGate := range pod.Spec.ReadinessGates {
+			conditionType := readinessGate.ConditionType
+			for _, condition := range pod.Status.Conditions {
+				if condition.Type == conditionType {
+					if condition.Status == core.ConditionTrue {
+						trueConditions++
+					}
+					break
+				}
+			}
+		}
+		readinessGates = ratioCell(int64(trueConditions), int64(len(pod.Spec.ReadinessGates)))
+	}
+
+	/*
+		"name": "IP",
+		"name": "Node",
+		"name": "Nominated Node",
+		"name": "Readiness Gates",
+	*/
+	row["IP"] = optionalCell(podIP, "<none>")
+	row["Node"] = optionalCell(nodeName, "<none>")
+	row["Nominated Node"] = optionalCell(nominatedNodeName, "<none>")
+	row["Readiness Gates"] = readinessGates
+
+	var priority int64
+	if pod.Spec.Priority != nil {
+		priority = int64(*pod.Spec.Priority)
+	}
+	controlledBy := ""
+	if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil {
+		gv, err := schema.ParseGroupVersion(controllerRef.APIVersion)
+		if err != nil {
+			return nil, err
+		}
+		controlledBy = formatResourceName(gv.WithKind(controllerRef.Kind).GroupKind(), controllerRef.Name, true)
+	}
+	requests, limits := podRequestsAndLimits(pod)
+
+	/*
+		"name": "QoS Class",
+		"name": "Priority",
+		"name": "Priority Class",
+		"name": "Service Account",
+		"name": "Controlled By",
+		"name": "Host Network",
+		"name": "CPU Requests",
+		"name": "CPU Limits",
+		"name": "Memory Requests",
+		"name": "Memory Limits",
+		"name": "Host IP",
+	*/
+	row["QoS Class"] = optionalCell(string(pod.Status.QOSClass), "<none>")
+	row["Priority"] = priority
+	row["Priority Class"] = optionalCell(pod.Spec.PriorityClassName, "<none>")
+	row["Service Account"] = optionalCell(pod.Spec.ServiceAccountName, "<none>")
+	row["Controlled By"] = optionalCell(controlledBy, "<none>")
+	row["Host Network"] = pod.Spec.HostNetwork
+	row["CPU Requests"] = quantityCell(requests.Cpu())
+	row["CPU Limits"] = quantityCell(limits.Cpu())
+	row["Memory Requests"] = quantityCell(requests.Memory())
+	row["Memory Limits"] = quantityCell(limits.Memory())
+	row["Host IP"] = optionalCell(pod.Status.HostIP, "<none>")
+
+	if p.ContainerDetails {
+		row[ContainerDetailsKey] = containerDetails(pod)
+	}
+
+	return row, nil
+}
+
+func (_ PodPrinter) Health(o runtime.Object) Health {
+	pod, ok := o.(*core.Pod)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	reason, readyContainers, _, _ := podStatus(pod)
+	return podHealth(reason, readyContainers, len(pod.Spec.Containers))
+}
+
+// podStatus returns the Status of a pod as kubectl prints it, its ready
+// containers, its restarts and the last time a container terminated.
+func podStatus(pod *core.Pod) (string, int, int, metav1.Time) {
+	restarts := 0
+	lastRestartDate := metav1.NewTime(time.Time{})
 	readyContainers := 0
 
 	reason := string(pod.Status.Phase)
@@ -50,12 +199,16 @@ func (p PodPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 		reason = pod.Status.Reason
 	}
 
-	row := map[string]interface{}{}
-
 	initializing := false
 	for i := range pod.Status.InitContainerStatuses {
 		container := pod.Status.InitContainerStatuses[i]
 		restarts += int(container.RestartCount)
+		if container.LastTerminationState.Terminated != nil {
+			terminatedDate := container.LastTerminationState.Terminated.FinishedAt
+			if lastRestartDate.Before(&terminatedDate) {
+				lastRestartDate = terminatedDate
+			}
+		}
 		switch {
 		case container.State.Terminated != nil && container.State.Terminated.ExitCode == 0:
 			continue
@@ -82,11 +235,18 @@ func (p PodPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	}
 	if !initializing {
 		restarts = 0
+		lastRestartDate = metav1.NewTime(time.Time{})
 		hasRunning := false
 		for i := len(pod.Status.ContainerStatuses) - 1; i >= 0; i-- {
 			container := pod.Status.ContainerStatuses[i]
 
 			restarts += int(container.RestartCount)
+			if container.LastTerminationState.Terminated != nil {
+				terminatedDate := container.LastTerminationState.Terminated.FinishedAt
+				if lastRestartDate.Before(&terminatedDate) {
+					lastRestartDate = terminatedDate
+				}
+			}
 			if container.State.Waiting != nil && container.State.Waiting.Reason != "" {
 				reason = container.State.Waiting.Reason
 			} else if container.State.Terminated != nil && container.State.Terminated.Reason != "" {
@@ -119,65 +279,102 @@ func (p PodPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 		reason = "Terminating"
 	}
 
-	/*
-		"name": "Name",
-		"name": "Ready",
-		"name": "Status",
-		"name": "Restarts",
-		"name": "Age",
-	*/
-	row["Name"] = pod.Name
-	row["Ready"] = fmt.Sprintf("%d/%d", readyContainers, totalContainers)
-	row["Status"] = reason
-	row["Restarts"] = int64(restarts)
-	row["Age"] = translateTimestampSince(pod.CreationTimestamp)
+	return reason, readyContainers, restarts, lastRestartDate
+}
 
-	nodeName := pod.Spec.NodeName
-	nominatedNodeName := pod.Status.NominatedNodeName
-	podIP := ""
-	if len(pod.Status.PodIPs) > 0 {
-		podIP = pod.Status.PodIPs[0].IP
-	}
+var containerDetailColumns = []metav1.TableColumnDefinition{
+	{Name: "Name", Type: "string", Description: core.Container{}.SwaggerDoc()["name"]},
+	{Name: "Type", Type: "string", Description: "Whether this is an init, regular or ephemeral container."},
+	{Name: "State", Type: "string", Description: "The current state of the container."},
+	{Name: "Reason", Type: "string", Description: "The reason the container is in its current state."},
+	{Name: "Ready", Type: "string", Description: core.ContainerStatus{}.SwaggerDoc()["ready"]},
+	{Name: "Restarts", Type: "integer", Description: core.ContainerStatus{}.SwaggerDoc()["restartCount"]},
+	{Name: "Image", Type: "string", Description: core.ContainerStatus{}.SwaggerDoc()["image"]},
+	{Name: "Image ID", Type: "string", Description: core.ContainerStatus{}.SwaggerDoc()["imageID"]},
+}
 
-	if podIP == "" {
-		podIP = "<none>"
+func containerDetails(pod *core.Pod) SubTable {
+	rows := make([]map[string]interface{}, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers)+len(pod.Spec.EphemeralContainers))
+	for _, c := range pod.Spec.InitContainers {
+		rows = append(rows, containerDetail("Init", c.Name, c.Image, pod.Status.InitContainerStatuses))
 	}
-	if nodeName == "" {
-		nodeName = "<none>"
+	for _, c := range pod.Spec.Containers {
+		rows = append(rows, containerDetail("Container", c.Name, c.Image, pod.Status.ContainerStatuses))
 	}
-	if nominatedNodeName == "" {
-		nominatedNodeName = "<none>"
+	for _, c := range pod.Spec.EphemeralContainers {
+		rows = append(rows, containerDetail("Ephemeral", c.Name, c.Image, pod.Status.EphemeralContainerStatuses))
 	}
+	return SubTable{Columns: containerDetailColumns, Rows: rows}
+}
 
-	readinessGates := "<none>"
-	if len(pod.Spec.ReadinessGates) > 0 {
-		trueConditions := 0
-		for _, readinessGate := range pod.Spec.ReadinessGates {
-			conditionType := readinessGate.ConditionType
-			for _, condition := range pod.Status.Conditions {
-				if condition.Type == conditionType {
-					if condition.Status == core.ConditionTrue {
-						trueConditions++
-					}
-					break
-				}
-			}
+func containerDetail(containerType, name, image string, statuses []core.ContainerStatus) map[string]interface{} {
+	row := map[string]interface{}{
+		"Name":     name,
+		"Type":     containerType,
+		"State":    "<unknown>",
+		"Reason":   "<none>",
+		"Ready":    printBool(false),
+		"Restarts": int64(0),
+		"Image":    image,
+		"Image ID": "<none>",
+	}
+
+	for _, status := range statuses {
+		if status.Name != name {
+			continue
+		}
+
+		state, reason := containerStateReason(status.State)
+		row["State"] = state
+		row["Reason"] = reason
+		row["Ready"] = printBool(status.Ready)
+		row["Restarts"] = int64(status.RestartCount)
+		if status.Image != "" {
+			row["Image"] = status.Image
 		}
-		readinessGates = fmt.Sprintf("%d/%d", trueConditions, len(pod.Spec.ReadinessGates))
+		if status.ImageID != "" {
+			row["Image ID"] = status.ImageID
+		}
+		break
 	}
+	return row
+}
 
-	/*
-		"name": "IP",
-		"name": "Node",
-		"name": "Nominated Node",
-		"name": "Readiness Gates",
-	*/
-	row["IP"] = podIP
-	row["Node"] = nodeName
-	row["Nominated Node"] = nominatedNodeName
-	row["Readiness Gates"] = readinessGates
+func containerStateReason(state core.ContainerState) (string, string) {
+	switch {
+	case state.Running != nil:
+		return "Running", "<none>"
+	case state.Waiting != nil:
+		if state.Waiting.Reason == "" {
+			return "Waiting", "<none>"
+		}
+		return "Waiting", state.Waiting.Reason
+	case state.Terminated != nil:
+		switch {
+		case state.Terminated.Reason != "":
+			return "Terminated", state.Terminated.Reason
+		case state.Terminated.Signal != 0:
+			return "Terminated", fmt.Sprintf("Signal:%d", state.Terminated.Signal)
+		default:
+			return "Terminated", fmt.Sprintf("ExitCode:%d", state.Terminated.ExitCode)
+		}
+	}
+	return "<unknown>", "<none>"
+}
 
-	return row, nil
+// PodRestarts is the Restarts cell of a pod row. Count is the total number of
+// container restarts and LastRestart is the most recent time a container
+// terminated, zero if none did. It prints like kubectl 1.22+, e.g. "5 (3m12s ago)".
+type PodRestarts struct {
+	Count       int64       `json:"count"`
+	LastRestart metav1.Time `json:"lastRestart,omitempty"`
+}
+
+func (r PodRestarts) String() string {
+	if r.LastRestart.IsZero() {
+		return strconv.FormatInt(r.Count, 10)
+	}
+	return fmt.Sprintf("%d (%s ago)", r.Count, translateTimestampSince(r.LastRestart))
 }
 
 func hasPodReadyCondition(conditions []core.PodCondition) bool {
@@ -0,0 +1,100 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	metrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func init() {
+	Register(PodMetricsPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubectl/blob/v0.21.0/pkg/metricsutil/metrics_printer.go
+
+// PodMetricsPrinter converts PodMetrics like kubectl top pod. Percentages of
+// the requests and limits of the containers are computed for the pods found
+// in Pods, see NewUsageTables.
+type PodMetricsPrinter struct {
+	Pods map[types.NamespacedName]*core.Pod
+}
+
+var _ ColumnConverter = PodMetricsPrinter{}
+var _ HealthConverter = PodMetricsPrinter{}
+
+func (_ PodMetricsPrinter) GVK() schema.GroupVersionKind {
+	return metrics.SchemeGroupVersion.WithKind("PodMetrics")
+}
+
+func (_ PodMetricsPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "CPU(cores)", Type: "integer", Description: "The CPU usage of the containers of the pod, in millicores."},
+		{Name: "CPU%", Type: "number", Description: "The CPU usage in percent of the CPU requests of the pod."},
+		{Name: "Memory(bytes)", Type: "integer", Description: "The memory working set of the containers of the pod, in bytes."},
+		{Name: "Memory%", Type: "number", Description: "The memory usage in percent of the memory requests of the pod."},
+		{Name: "CPU Limit%", Type: "number", Priority: 1, Description: "The CPU usage in percent of the CPU limits of the pod."},
+		{Name: "Memory Limit%", Type: "number", Priority: 1, Description: "The memory usage in percent of the memory limits of the pod."},
+		{Name: "Window", Type: "string", Priority: PriorityExtended, Description: "The length of the interval the usage was measured over."},
+	}
+}
+
+func (p PodMetricsPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*metrics.PodMetrics)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	usage := podUsage(obj)
+
+	row["Name"] = obj.Name
+	row["CPU(cores)"] = cpuCell(usage)
+	row["Memory(bytes)"] = memoryCell(usage)
+	row["Window"] = Cell{Raw: obj.Window.Duration, Display: obj.Window.Duration.String()}
+
+	pod, found := p.Pods[types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}]
+	if !found {
+		unknown := Cell{Display: "<unknown>"}
+		row["CPU%"], row["Memory%"], row["CPU Limit%"], row["Memory Limit%"] = unknown, unknown, unknown, unknown
+		return row, nil
+	}
+
+	requests, limits := podRequestsAndLimits(pod)
+	row["CPU%"] = usagePercentCell(usage, requests, core.ResourceCPU)
+	row["Memory%"] = usagePercentCell(usage, requests, core.ResourceMemory)
+	row["CPU Limit%"] = usagePercentCell(usage, limits, core.ResourceCPU)
+	row["Memory Limit%"] = usagePercentCell(usage, limits, core.ResourceMemory)
+
+	return row, nil
+}
+
+// Health is Unknown for pods that are not in Pods.
+func (p PodMetricsPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*metrics.PodMetrics)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	pod, found := p.Pods[types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}]
+	if !found {
+		return Health{Level: HealthUnknown}
+	}
+	_, limits := podRequestsAndLimits(pod)
+	return usageHealth(podUsage(obj), limits)
+}
+
+// podUsage returns the total usage of the containers of a pod.
+func podUsage(obj *metrics.PodMetrics) core.ResourceList {
+	usage := core.ResourceList{}
+	for _, c := range obj.Containers {
+		addResourceList(usage, c.Usage)
+	}
+	return usage
+}
@@ -0,0 +1,52 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(PodTemplatePrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L103-L108
+
+type PodTemplatePrinter struct{}
+
+var _ ColumnConverter = PodTemplatePrinter{}
+
+func (_ PodTemplatePrinter) GVK() schema.GroupVersionKind {
+	return core.SchemeGroupVersion.WithKind("PodTemplate")
+}
+
+func (_ PodTemplatePrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Containers", Type: "string", Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Description: "Images referenced by each container in the template."},
+		{Name: "Pod Labels", Type: "string", Description: "The labels for the pod template."},
+	}
+}
+
+func (p PodTemplatePrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*core.PodTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	names, images := layoutContainerCells(obj.Template.Spec.Containers)
+	row["Name"] = obj.Name
+	row["Containers"] = names
+	row["Images"] = images
+	row["Pod Labels"] = labels.FormatLabels(obj.Template.Labels)
+
+	return row, nil
+}
@@ -0,0 +1,129 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(PersistentVolumeClaimPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L312-L321
+
+type PersistentVolumeClaimPrinter struct{}
+
+var _ ColumnConverter = PersistentVolumeClaimPrinter{}
+var _ HealthConverter = PersistentVolumeClaimPrinter{}
+
+func (_ PersistentVolumeClaimPrinter) GVK() schema.GroupVersionKind {
+	return core.SchemeGroupVersion.WithKind("PersistentVolumeClaim")
+}
+
+func (_ PersistentVolumeClaimPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Status", Type: "string", Description: core.PersistentVolumeClaimStatus{}.SwaggerDoc()["phase"]},
+		{Name: "Volume", Type: "string", Description: core.PersistentVolumeClaimSpec{}.SwaggerDoc()["volumeName"]},
+		{Name: "Capacity", Type: "string", Description: core.PersistentVolumeClaimStatus{}.SwaggerDoc()["capacity"]},
+		{Name: "Access Modes", Type: "string", Description: core.PersistentVolumeClaimStatus{}.SwaggerDoc()["accessModes"]},
+		{Name: "StorageClass", Type: "string", Description: "StorageClass of the pvc"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "VolumeMode", Type: "string", Priority: 1, Description: core.PersistentVolumeClaimSpec{}.SwaggerDoc()["volumeMode"]},
+	}
+}
+
+func (p PersistentVolumeClaimPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*core.PersistentVolumeClaim)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	phase := pvcPhase(obj)
+
+	capacity := ""
+	accessModes := ""
+	volumeMode := ""
+	if obj.Spec.VolumeName != "" {
+		accessModes = getAccessModesAsString(obj.Status.AccessModes)
+		storage := obj.Status.Capacity[core.ResourceStorage]
+		capacity = storage.String()
+	}
+	if obj.Spec.VolumeMode != nil {
+		volumeMode = string(*obj.Spec.VolumeMode)
+	}
+
+	row["Name"] = obj.Name
+	row["Status"] = phase
+	row["Volume"] = obj.Spec.VolumeName
+	row["Capacity"] = capacity
+	row["Access Modes"] = accessModes
+	row["StorageClass"] = getPersistentVolumeClaimClass(obj)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
+	row["VolumeMode"] = optionalCell(volumeMode, "<unset>")
+
+	return row, nil
+}
+
+func (_ PersistentVolumeClaimPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*core.PersistentVolumeClaim)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return pvcHealth(pvcPhase(obj))
+}
+
+func pvcPhase(obj *core.PersistentVolumeClaim) string {
+	if obj.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	return string(obj.Status.Phase)
+}
+
+// getAccessModesAsString returns a string representation of an array of access modes.
+// modes, when present, are always in the same order: RWO,ROX,RWX.
+func getAccessModesAsString(modes []core.PersistentVolumeAccessMode) string {
+	modesStr := []string{}
+	if containsAccessMode(modes, core.ReadWriteOnce) {
+		modesStr = append(modesStr, "RWO")
+	}
+	if containsAccessMode(modes, core.ReadOnlyMany) {
+		modesStr = append(modesStr, "ROX")
+	}
+	if containsAccessMode(modes, core.ReadWriteMany) {
+		modesStr = append(modesStr, "RWX")
+	}
+	return strings.Join(modesStr, ",")
+}
+
+func containsAccessMode(modes []core.PersistentVolumeAccessMode, mode core.PersistentVolumeAccessMode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// getPersistentVolumeClaimClass returns StorageClassName. If no storage class was
+// requested, it returns "".
+func getPersistentVolumeClaimClass(claim *core.PersistentVolumeClaim) string {
+	// Use beta annotation first
+	if class, found := claim.Annotations[core.BetaStorageClassAnnotation]; found {
+		return class
+	}
+
+	if claim.Spec.StorageClassName != nil {
+		return *claim.Spec.StorageClassName
+	}
+
+	return ""
+}
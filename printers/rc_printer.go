@@ -6,6 +6,7 @@ import (
 
 	"gomodules.xyz/pointer"
 	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -20,11 +21,25 @@ func init() {
 type ReplicationControllerPrinter struct{}
 
 var _ ColumnConverter = ReplicationControllerPrinter{}
+var _ HealthConverter = ReplicationControllerPrinter{}
 
 func (_ ReplicationControllerPrinter) GVK() schema.GroupVersionKind {
 	return core.SchemeGroupVersion.WithKind("ReplicationController")
 }
 
+func (_ ReplicationControllerPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Desired", Type: "integer", Description: core.ReplicationControllerSpec{}.SwaggerDoc()["replicas"]},
+		{Name: "Current", Type: "integer", Description: core.ReplicationControllerStatus{}.SwaggerDoc()["replicas"]},
+		{Name: "Ready", Type: "integer", Description: core.ReplicationControllerStatus{}.SwaggerDoc()["readyReplicas"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+		{Name: "Selector", Type: "string", Priority: 1, Description: core.ReplicationControllerSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
 func (p ReplicationControllerPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*core.ReplicationController)
 	if !ok {
@@ -41,7 +56,7 @@ func (p ReplicationControllerPrinter) Convert(o runtime.Object) (map[string]inte
 	row["Desired"] = int64(pointer.Int32(desiredReplicas))
 	row["Current"] = int64(currentReplicas)
 	row["Ready"] = int64(readyReplicas)
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	names, images := layoutContainerCells(obj.Spec.Template.Spec.Containers)
 	row["Containers"] = names
@@ -50,3 +65,11 @@ func (p ReplicationControllerPrinter) Convert(o runtime.Object) (map[string]inte
 
 	return row, nil
 }
+
+func (_ ReplicationControllerPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*core.ReplicationController)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return replicasHealth(int64(pointer.Int32(obj.Spec.Replicas)), int64(obj.Status.ReadyReplicas))
+}
@@ -2,7 +2,11 @@ package printers
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -12,17 +16,153 @@ type ColumnConverter interface {
 	Convert(obj runtime.Object) (map[string]interface{}, error)
 }
 
+// ColumnDefiner is implemented by converters that define their columns,
+// like all built-in converters. Tables of converters that do not get a
+// string column per cell, with Name first and the others sorted by name.
+type ColumnDefiner interface {
+	Columns() []metav1.TableColumnDefinition
+}
+
+// HealthConverter is implemented by converters that classify the health of
+// the objects they convert, e.g. the built-in converters of pods, nodes and
+// workloads. Rows of other converters have an Unknown health.
+type HealthConverter interface {
+	Health(obj runtime.Object) Health
+}
+
 var printers = map[schema.GroupVersionKind]ColumnConverter{}
 
+// Register registers c for the kind it converts. It replaces the converter
+// registered before for the kind, so built-in converters can be overridden.
+// Use Extend to add columns to a kind instead.
 func Register(c ColumnConverter) {
 	printers[c.GVK()] = c
+	updateExtended(c.GVK())
+}
+
+// Columns returns the column definitions registered for gvk, including
+// those of column extensions. It returns nil if the converter of gvk does
+// not implement ColumnDefiner.
+func Columns(gvk schema.GroupVersionKind) ([]metav1.TableColumnDefinition, error) {
+	c, ok := lookup(gvk)
+	if !ok {
+		return nil, fmt.Errorf("no column converter registered for %+v", gvk)
+	}
+	return columnsOf(c), nil
 }
 
+// Convert returns the cells of the columns kubectl get -o wide would print.
+// Use ConvertWithPriority to include PriorityExtended columns.
 func Convert(o runtime.Object) (map[string]interface{}, error) {
-	gvk := o.GetObjectKind().GroupVersionKind()
-	c, ok := printers[gvk]
+	return ConvertWithPriority(o, PriorityWide)
+}
+
+// ConvertWithPriority drops the cells of columns whose priority is higher
+// than the given one.
+func ConvertWithPriority(o runtime.Object, priority int32) (map[string]interface{}, error) {
+	c, err := converterFor(o, o.GetObjectKind().GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	row, err := convert(c, o, priority)
+	if err != nil {
+		return nil, err
+	}
+	return row.Cells, nil
+}
+
+// ConvertList converts every item of a list object, e.g. a PodList or a
+// PartialObjectMetadataList. Items without type information are looked up
+// using the GVK of the list.
+func ConvertList(list runtime.Object) ([]map[string]interface{}, error) {
+	itemGVK := list.GetObjectKind().GroupVersionKind()
+	itemGVK.Kind = strings.TrimSuffix(itemGVK.Kind, "List")
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		gvk := item.GetObjectKind().GroupVersionKind()
+		if gvk.Empty() {
+			gvk = itemGVK
+		}
+		c, err := converterFor(item, gvk)
+		if err != nil {
+			return nil, err
+		}
+		row, err := convert(c, item, PriorityWide)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row.Cells)
+	}
+	return rows, nil
+}
+
+func convert(c ColumnConverter, o runtime.Object, priority int32) (Row, error) {
+	cells, err := c.Convert(o)
+	if err != nil {
+		return Row{}, err
+	}
+	for _, col := range columnsOf(c) {
+		if col.Priority > priority {
+			delete(cells, col.Name)
+		}
+	}
+	return Row{Object: o, Cells: cells, Health: healthOf(c, o)}, nil
+}
+
+// HasConverter reports whether a converter is registered for the kind of o.
+// Objects without one, e.g. custom resources or ConfigMaps, cannot be
+// converted.
+func HasConverter(o runtime.Object) bool {
+	_, err := converterFor(o, o.GetObjectKind().GroupVersionKind())
+	return err == nil
+}
+
+func converterFor(o runtime.Object, gvk schema.GroupVersionKind) (ColumnConverter, error) {
+	if _, ok := o.(*metav1.PartialObjectMetadata); ok {
+		gvk = metav1.SchemeGroupVersion.WithKind("PartialObjectMetadata")
+	}
+	c, ok := lookup(gvk)
 	if !ok {
 		return nil, fmt.Errorf("no column converter registered for %+v", gvk)
 	}
-	return c.Convert(o)
+	return c, nil
+}
+
+// columnsOf returns the columns of c. Converters that do not implement
+// ColumnDefiner get a string column per cell of rows.
+func columnsOf(c ColumnConverter, rows ...map[string]interface{}) []metav1.TableColumnDefinition {
+	if d, ok := c.(ColumnDefiner); ok {
+		return d.Columns()
+	}
+
+	seen := map[string]bool{}
+	var names []string
+	for _, row := range rows {
+		for k, v := range row {
+			if _, ok := v.(SubTable); ok || seen[k] {
+				continue
+			}
+			seen[k] = true
+			names = append(names, k)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if (names[i] == "Name") != (names[j] == "Name") {
+			return names[i] == "Name"
+		}
+		return names[i] < names[j]
+	})
+	columns := make([]metav1.TableColumnDefinition, len(names))
+	for i, name := range names {
+		columns[i] = metav1.TableColumnDefinition{Name: name, Type: "string"}
+		if name == "Name" {
+			columns[i].Format = "name"
+		}
+	}
+	return columns
 }
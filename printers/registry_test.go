@@ -0,0 +1,186 @@
+package printers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// cellsOnlyConverter is a converter without column definitions.
+type cellsOnlyConverter struct{}
+
+func (cellsOnlyConverter) GVK() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+}
+
+func (cellsOnlyConverter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	m := o.(*metav1.PartialObjectMetadata)
+	return map[string]interface{}{
+		"Size": m.Labels["size"],
+		"Name": m.Name,
+		"Age":  timestampCell(m.CreationTimestamp),
+	}, nil
+}
+
+func TestBuiltinConvertersDefineColumns(t *testing.T) {
+	for gvk, c := range printers {
+		if _, ok := c.(ColumnDefiner); !ok {
+			t.Errorf("converter for %v does not define its columns", gvk)
+		}
+	}
+}
+
+func TestConverterWithoutColumns(t *testing.T) {
+	c := cellsOnlyConverter{}
+	tests := []struct {
+		name string
+		exts []ColumnExtension
+		want []string
+	}{
+		{name: "cells", want: []string{"Name", "Age", "Size"}},
+		{
+			name: "extended",
+			exts: []ColumnExtension{{Column: metav1.TableColumnDefinition{Name: "Color"}, JSONPath: "{.metadata.labels.color}"}},
+			want: []string{"Name", "Age", "Color", "Size"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer ResetExtensions(c.GVK())
+			if err := Extend(c.GVK(), tt.exts...); err != nil {
+				t.Fatal(err)
+			}
+
+			o := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "w", Labels: map[string]string{"size": "L", "color": "red"}}}
+			o.SetGroupVersionKind(c.GVK())
+			// PartialObjectMetadata would be looked up as such by NewTables
+			tables, err := newTables(PriorityDefault, func(runtime.Object, schema.GroupVersionKind) (ColumnConverter, error) {
+				return extend(c), nil
+			}, o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, col := range tables[0].Columns {
+				got = append(got, col.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got columns %v, want %v", got, tt.want)
+			}
+			if tables[0].Columns[0].Format != "name" {
+				t.Errorf("got Name format %q, want name", tables[0].Columns[0].Format)
+			}
+		})
+	}
+}
+
+func TestRowHealth(t *testing.T) {
+	three := int32(3)
+	tests := []struct {
+		name string
+		obj  runtime.Object
+		exts []ColumnExtension
+		want Health
+	}{
+		{
+			name: "crash looping pod",
+			obj: &core.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "api-5d9c"},
+				Spec:       core.PodSpec{Containers: []core.Container{{Name: "api"}}},
+				Status: core.PodStatus{
+					Phase:             core.PodRunning,
+					ContainerStatuses: []core.ContainerStatus{{Name: "api", State: core.ContainerState{Waiting: &core.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}}},
+				},
+			},
+			want: Health{Level: HealthError, Reason: "CrashLoopBackOff"},
+		},
+		{
+			name: "deployment without available replicas",
+			obj: &apps.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web"},
+				Spec:       apps.DeploymentSpec{Replicas: &three},
+			},
+			want: Health{Level: HealthWarning, Reason: "NoReplicasReady"},
+		},
+		{
+			name: "extended deployment",
+			obj: &apps.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Labels: map[string]string{"team": "storefront"}},
+				Spec:       apps.DeploymentSpec{Replicas: &three},
+				Status:     apps.DeploymentStatus{AvailableReplicas: 2},
+			},
+			exts: []ColumnExtension{{Column: metav1.TableColumnDefinition{Name: "Team"}, JSONPath: "{.metadata.labels.team}"}},
+			want: Health{Level: HealthProgressing, Reason: "2/3 replicas ready"},
+		},
+		{
+			name: "pending load balancer",
+			obj: &core.Service{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Service"},
+				ObjectMeta: metav1.ObjectMeta{Name: "edge"},
+				Spec:       core.ServiceSpec{Type: core.ServiceTypeLoadBalancer},
+			},
+			want: Health{Level: HealthProgressing, Reason: "LoadBalancerPending"},
+		},
+		{
+			name: "cordoned node",
+			obj: &core.Node{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+				ObjectMeta: metav1.ObjectMeta{Name: "worker-2"},
+				Spec:       core.NodeSpec{Unschedulable: true},
+				Status:     core.NodeStatus{Conditions: []core.NodeCondition{{Type: core.NodeReady, Status: core.ConditionTrue}}},
+			},
+			want: Health{Level: HealthWarning, Reason: "Ready,SchedulingDisabled"},
+		},
+		{
+			name: "deleted claim",
+			obj: &core.PersistentVolumeClaim{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PersistentVolumeClaim"},
+				ObjectMeta: metav1.ObjectMeta{Name: "data-0", DeletionTimestamp: &metav1.Time{}},
+				Status:     core.PersistentVolumeClaimStatus{Phase: core.ClaimBound},
+			},
+			want: Health{Level: HealthProgressing, Reason: "Terminating"},
+		},
+		{
+			name: "kind without health",
+			obj: &core.PodTemplate{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "PodTemplate"},
+				ObjectMeta: metav1.ObjectMeta{Name: "batch-runner"},
+			},
+			want: Health{Level: HealthUnknown},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gvk := tt.obj.GetObjectKind().GroupVersionKind()
+			if len(tt.exts) > 0 {
+				defer ResetExtensions(gvk)
+				if err := Extend(gvk, tt.exts...); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tables, err := NewTables(PriorityWide, tt.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			row := tables[0].Rows[0]
+			if row.Health != tt.want {
+				t.Errorf("got health %v, want %v", row.Health, tt.want)
+			}
+			for k := range row.Cells {
+				if strings.HasPrefix(k, "_") {
+					t.Errorf("row has a cell %q that is not a column", k)
+				}
+			}
+		})
+	}
+}
@@ -20,11 +20,25 @@ func init() {
 type ReplicaSetPrinter struct{}
 
 var _ ColumnConverter = ReplicaSetPrinter{}
+var _ HealthConverter = ReplicaSetPrinter{}
 
 func (_ ReplicaSetPrinter) GVK() schema.GroupVersionKind {
 	return apps.SchemeGroupVersion.WithKind("ReplicaSet")
 }
 
+func (_ ReplicaSetPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Desired", Type: "integer", Description: apps.ReplicaSetSpec{}.SwaggerDoc()["replicas"]},
+		{Name: "Current", Type: "integer", Description: apps.ReplicaSetStatus{}.SwaggerDoc()["replicas"]},
+		{Name: "Ready", Type: "integer", Description: apps.ReplicaSetStatus{}.SwaggerDoc()["readyReplicas"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+		{Name: "Selector", Type: "string", Priority: 1, Description: apps.ReplicaSetSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
 func (p ReplicaSetPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*apps.ReplicaSet)
 	if !ok {
@@ -41,7 +55,7 @@ func (p ReplicaSetPrinter) Convert(o runtime.Object) (map[string]interface{}, er
 	row["Desired"] = int64(pointer.Int32(desiredReplicas))
 	row["Current"] = int64(currentReplicas)
 	row["Ready"] = int64(readyReplicas)
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 
 	names, images := layoutContainerCells(obj.Spec.Template.Spec.Containers)
 	row["Containers"] = names
@@ -50,3 +64,11 @@ func (p ReplicaSetPrinter) Convert(o runtime.Object) (map[string]interface{}, er
 
 	return row, nil
 }
+
+func (_ ReplicaSetPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*apps.ReplicaSet)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return replicasHealth(int64(pointer.Int32(obj.Spec.Replicas)), int64(obj.Status.ReadyReplicas))
+}
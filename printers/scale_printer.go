@@ -0,0 +1,46 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	autoscaling "k8s.io/api/autoscaling/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(ScalePrinter{})
+}
+
+type ScalePrinter struct{}
+
+var _ ColumnConverter = ScalePrinter{}
+
+func (_ ScalePrinter) GVK() schema.GroupVersionKind {
+	return autoscaling.SchemeGroupVersion.WithKind("Scale")
+}
+
+func (_ ScalePrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Desired", Type: "integer", Description: autoscaling.ScaleSpec{}.SwaggerDoc()["replicas"]},
+		{Name: "Available", Type: "integer", Description: autoscaling.ScaleStatus{}.SwaggerDoc()["replicas"]},
+	}
+}
+
+func (p ScalePrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*autoscaling.Scale)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	row["Name"] = obj.Name
+	row["Desired"] = int64(obj.Spec.Replicas)
+	row["Available"] = int64(obj.Status.Replicas)
+
+	return row, nil
+}
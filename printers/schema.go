@@ -0,0 +1,134 @@
+package printers
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+//go:generate go run ../hack/gen-row-schema ../schema/row.v1.json
+
+// RowJSONSchemaID is the $id of the JSON Schema returned by RowJSONSchema.
+const RowJSONSchemaID = "https://github.com/tamalsaha/table-printer/schema/row." + RowSchemaVersion + ".json"
+
+// RowJSONSchema returns a JSON Schema of the RowDocuments of every
+// registered kind, generated from their column definitions.
+func RowJSONSchema() ([]byte, error) {
+	gvks := make([]schema.GroupVersionKind, 0, len(printers))
+	for gvk := range printers {
+		gvks = append(gvks, gvk)
+	}
+	sort.Slice(gvks, func(i, j int) bool {
+		return gvks[i].String() < gvks[j].String()
+	})
+
+	defs := map[string]interface{}{
+		"health": map[string]interface{}{
+			"type":     "object",
+			"required": []string{"level"},
+			"properties": map[string]interface{}{
+				"level": map[string]interface{}{
+					"enum": []HealthLevel{HealthOK, HealthProgressing, HealthWarning, HealthError, HealthUnknown},
+				},
+				"reason": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+	// sub-rows, e.g. the containers of a pod, by name
+	defs["subRows"] = map[string]interface{}{
+		"type": "object",
+		"additionalProperties": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"type":                 "object",
+				"additionalProperties": columnSchema(metav1.TableColumnDefinition{Type: "string"}),
+			},
+		},
+	}
+	oneOf := make([]interface{}, 0, len(gvks))
+	for _, gvk := range gvks {
+		name := schemaDefName(gvk)
+		defs[name] = rowSchema(gvk, columnsOf(extend(printers[gvk])))
+		oneOf = append(oneOf, map[string]interface{}{"$ref": "#/$defs/" + name})
+	}
+
+	return json.MarshalIndent(map[string]interface{}{
+		"$schema":     "https://json-schema.org/draft/2020-12/schema",
+		"$id":         RowJSONSchemaID,
+		"title":       "Converted table row",
+		"description": "A row converted by a registered ColumnConverter, as written by JSONWriter.",
+		"oneOf":       oneOf,
+		"$defs":       defs,
+	}, "", "  ")
+}
+
+func schemaDefName(gvk schema.GroupVersionKind) string {
+	if gvk.Group == "" {
+		return gvk.Version + "." + gvk.Kind
+	}
+	return gvk.Group + "." + gvk.Version + "." + gvk.Kind
+}
+
+func rowSchema(gvk schema.GroupVersionKind, columns []metav1.TableColumnDefinition) map[string]interface{} {
+	apiVersion, kind := gvk.ToAPIVersionAndKind()
+
+	props := make(map[string]interface{}, len(columns))
+	for _, col := range columns {
+		props[col.Name] = columnSchema(col)
+	}
+
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []string{"schemaVersion", "apiVersion", "kind", "name", "health", "columns"},
+		"properties": map[string]interface{}{
+			"schemaVersion": map[string]interface{}{"const": RowSchemaVersion},
+			"apiVersion":    map[string]interface{}{"const": apiVersion},
+			"kind":          map[string]interface{}{"const": kind},
+			"namespace":     map[string]interface{}{"type": "string"},
+			"name":          map[string]interface{}{"type": "string"},
+			"health":        map[string]interface{}{"$ref": "#/$defs/health"},
+			"columns": map[string]interface{}{
+				"type":       "object",
+				"properties": props,
+				// custom columns
+				"additionalProperties": columnSchema(metav1.TableColumnDefinition{Type: "string"}),
+			},
+			"subRows": map[string]interface{}{"$ref": "#/$defs/subRows"},
+		},
+	}
+}
+
+func columnSchema(col metav1.TableColumnDefinition) map[string]interface{} {
+	s := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"raw", "display"},
+		"properties": map[string]interface{}{
+			"raw":     rawSchema(col),
+			"display": map[string]interface{}{"type": "string"},
+		},
+		"additionalProperties": false,
+	}
+	if col.Description != "" {
+		s["description"] = col.Description
+	}
+	return s
+}
+
+// rawSchema maps the type of a column to the JSON types of its raw values.
+// Columns of type string may also hold timestamps, durations in seconds and
+// lists, see Cell.
+func rawSchema(col metav1.TableColumnDefinition) map[string]interface{} {
+	switch strings.ToLower(col.Type) {
+	case "integer", "number", "boolean":
+		return map[string]interface{}{"type": []string{strings.ToLower(col.Type), "null"}}
+	case "date":
+		return map[string]interface{}{"type": []string{"string", "null"}, "format": "date-time"}
+	}
+	return map[string]interface{}{
+		"type":  []string{"string", "integer", "boolean", "array", "null"},
+		"items": map[string]interface{}{"type": "string"},
+	}
+}
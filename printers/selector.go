@@ -0,0 +1,159 @@
+package printers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	apps "k8s.io/api/apps/v1"
+	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Selector filters objects by their labels and fields before they are
+// converted, like the label and field selectors of list calls.
+type Selector struct {
+	Labels labels.Selector
+	Fields fields.Selector
+}
+
+// ParseSelector parses a label selector, e.g. "app=web,tier!=cache", and a
+// field selector, e.g. "status.phase=Running,spec.nodeName=node-1". Empty
+// selectors match everything.
+func ParseSelector(labelSelector, fieldSelector string) (Selector, error) {
+	ls, err := labels.Parse(labelSelector)
+	if err != nil {
+		return Selector{}, err
+	}
+	fs, err := fields.ParseSelector(fieldSelector)
+	if err != nil {
+		return Selector{}, err
+	}
+	return Selector{Labels: ls, Fields: fs}, nil
+}
+
+// Empty reports whether s matches everything.
+func (s Selector) Empty() bool {
+	return (s.Labels == nil || s.Labels.Empty()) && (s.Fields == nil || s.Fields.Empty())
+}
+
+// Matches reports whether o is selected. Empty selectors match every
+// object, including objects without metadata like a Status. Otherwise, like
+// the apiserver, it fails if the field selector uses a field that is not
+// supported for the kind of o, and objects without metadata are rejected.
+func (s Selector) Matches(o runtime.Object) (bool, error) {
+	if s.Empty() {
+		return true, nil
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return false, fmt.Errorf("cannot select %v: it has no object metadata", o.GetObjectKind().GroupVersionKind().Kind)
+	}
+	if s.Labels != nil && !s.Labels.Matches(labels.Set(m.GetLabels())) {
+		return false, nil
+	}
+	if s.Fields == nil || s.Fields.Empty() {
+		return true, nil
+	}
+
+	set, err := SelectableFields(o)
+	if err != nil {
+		return false, err
+	}
+	for _, r := range s.Fields.Requirements() {
+		if _, ok := set[r.Field]; !ok {
+			keys := make([]string, 0, len(set))
+			for k := range set {
+				keys = append(keys, strconv.Quote(k))
+			}
+			sort.Strings(keys)
+			return false, fmt.Errorf("%q is not a known field selector for %v: only %s", r.Field, o.GetObjectKind().GroupVersionKind().Kind, strings.Join(keys, ", "))
+		}
+	}
+	return s.Fields.Matches(set), nil
+}
+
+// SelectObjects returns the objects selected by s.
+func SelectObjects(objs []runtime.Object, s Selector) ([]runtime.Object, error) {
+	var result []runtime.Object
+	for _, o := range objs {
+		ok, err := s.Matches(o)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			result = append(result, o)
+		}
+	}
+	return result, nil
+}
+
+// SelectableFields returns the fields of o that field selectors can match:
+// metadata.name, metadata.namespace and the fields the apiserver supports
+// for the kind of o.
+// ref: https://github.com/kubernetes/kubernetes/tree/v1.21.0/pkg/registry
+func SelectableFields(o runtime.Object) (fields.Set, error) {
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return nil, err
+	}
+	set := fields.Set{
+		"metadata.name":      m.GetName(),
+		"metadata.namespace": m.GetNamespace(),
+	}
+
+	switch o := o.(type) {
+	case *core.Pod:
+		podIP := ""
+		if len(o.Status.PodIPs) > 0 {
+			podIP = o.Status.PodIPs[0].IP
+		}
+		set["spec.nodeName"] = o.Spec.NodeName
+		set["spec.restartPolicy"] = string(o.Spec.RestartPolicy)
+		set["spec.schedulerName"] = o.Spec.SchedulerName
+		set["spec.serviceAccountName"] = o.Spec.ServiceAccountName
+		set["status.phase"] = string(o.Status.Phase)
+		set["status.podIP"] = podIP
+		set["status.nominatedNodeName"] = o.Status.NominatedNodeName
+	case *core.Node:
+		set["spec.unschedulable"] = fmt.Sprint(o.Spec.Unschedulable)
+	case *core.Namespace:
+		set["status.phase"] = string(o.Status.Phase)
+		// supported by the apiserver for backward compatibility
+		set["name"] = o.Name
+	case *core.Secret:
+		set["type"] = string(o.Type)
+	case *core.Event:
+		source := o.Source.Component
+		if source == "" {
+			source = o.ReportingController
+		}
+		set["involvedObject.kind"] = o.InvolvedObject.Kind
+		set["involvedObject.namespace"] = o.InvolvedObject.Namespace
+		set["involvedObject.name"] = o.InvolvedObject.Name
+		set["involvedObject.uid"] = string(o.InvolvedObject.UID)
+		set["involvedObject.apiVersion"] = o.InvolvedObject.APIVersion
+		set["involvedObject.resourceVersion"] = o.InvolvedObject.ResourceVersion
+		set["involvedObject.fieldPath"] = o.InvolvedObject.FieldPath
+		set["reason"] = o.Reason
+		// reportingComponent is kept by the apiserver for backward compatibility
+		set["reportingComponent"] = o.ReportingController
+		set["source"] = source
+		set["type"] = o.Type
+	case *core.ReplicationController:
+		set["status.replicas"] = strconv.Itoa(int(o.Status.Replicas))
+	case *apps.ReplicaSet:
+		set["status.replicas"] = strconv.Itoa(int(o.Status.Replicas))
+	case *batch.Job:
+		set["status.successful"] = strconv.Itoa(int(o.Status.Succeeded))
+	case *core.PersistentVolumeClaim:
+		// supported by the apiserver for backward compatibility
+		set["name"] = o.Name
+	}
+	return set, nil
+}
@@ -0,0 +1,159 @@
+package printers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSelectObjects(t *testing.T) {
+	tests := []struct {
+		name   string
+		kind   string
+		labels string
+		fields string
+		want   []string
+		err    string
+	}{
+		{
+			name: "empty selectors match objects without metadata",
+			want: []string{
+				"Status/", "Pod/web", "Pod/cache", "Pod/dns", "Secret/cert", "Secret/token",
+				"Event/web.1", "Event/web.2", "Namespace/default", "Namespace/old",
+			},
+		},
+		{
+			name:   "label selector rejects objects without metadata",
+			labels: "app=web",
+			err:    "cannot select Status: it has no object metadata",
+		},
+		{
+			name:   "label equality",
+			kind:   "Pod",
+			labels: "app=web",
+			want:   []string{"Pod/web"},
+		},
+		{
+			name:   "label set and existence",
+			kind:   "Pod",
+			labels: "app in (web,dns),tier",
+			want:   []string{"Pod/web", "Pod/dns"},
+		},
+		{
+			name:   "label inequality matches missing labels",
+			kind:   "Pod",
+			labels: "tier!=frontend",
+			want:   []string{"Pod/cache", "Pod/dns"},
+		},
+		{
+			name:   "metadata fields",
+			kind:   "Pod",
+			fields: "metadata.namespace=default,metadata.name!=web",
+			want:   []string{"Pod/cache"},
+		},
+		{
+			name:   "pod fields",
+			kind:   "Pod",
+			fields: "status.phase=Running,spec.nodeName!=n1",
+			want:   []string{"Pod/dns"},
+		},
+		{
+			name:   "labels and fields",
+			kind:   "Pod",
+			labels: "tier",
+			fields: "spec.restartPolicy=Always",
+			want:   []string{"Pod/web", "Pod/dns"},
+		},
+		{
+			name:   "secret type",
+			kind:   "Secret",
+			fields: "type=kubernetes.io/tls",
+			want:   []string{"Secret/cert"},
+		},
+		{
+			name:   "event involved object",
+			kind:   "Event",
+			fields: "involvedObject.kind=Pod,involvedObject.name=web",
+			want:   []string{"Event/web.1"},
+		},
+		{
+			name:   "event type and reason",
+			kind:   "Event",
+			fields: "type!=Warning,reason=ScalingReplicaSet",
+			want:   []string{"Event/web.2"},
+		},
+		{
+			name:   "event source falls back to the reporting controller",
+			kind:   "Event",
+			fields: "source=deployment-controller",
+			want:   []string{"Event/web.2"},
+		},
+		{
+			name:   "namespace phase",
+			kind:   "Namespace",
+			fields: "status.phase=Active",
+			want:   []string{"Namespace/default"},
+		},
+		{
+			name:   "unsupported field",
+			kind:   "Pod",
+			fields: "spec.hostname=web",
+			err:    `"spec.hostname" is not a known field selector for Pod`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objs []runtime.Object
+			for _, o := range decodeFile(t, "selector.yaml") {
+				if tt.kind == "" || o.GetObjectKind().GroupVersionKind().Kind == tt.kind {
+					objs = append(objs, o)
+				}
+			}
+			s, err := ParseSelector(tt.labels, tt.fields)
+			if err != nil {
+				t.Fatal(err)
+			}
+			selected, err := SelectObjects(objs, s)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("got error %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := selectedNames(selected); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSelectorErrors(t *testing.T) {
+	tests := []struct {
+		labels, fields string
+	}{
+		{labels: "app in (web"},
+		{labels: "app in web"},
+		{fields: "status.phase"},
+	}
+	for _, tt := range tests {
+		if _, err := ParseSelector(tt.labels, tt.fields); err == nil {
+			t.Errorf("ParseSelector(%q, %q): expected an error", tt.labels, tt.fields)
+		}
+	}
+}
+
+// selectedNames returns "Kind/name" for each of objs, with an empty name
+// for objects without metadata.
+func selectedNames(objs []runtime.Object) []string {
+	names := make([]string, len(objs))
+	for i, o := range objs {
+		_, name := Row{Object: o}.namespaceName()
+		names[i] = o.GetObjectKind().GroupVersionKind().Kind + "/" + name
+	}
+	return names
+}
@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -22,11 +23,24 @@ func init() {
 type ServicePrinter struct{}
 
 var _ ColumnConverter = ServicePrinter{}
+var _ HealthConverter = ServicePrinter{}
 
 func (_ ServicePrinter) GVK() schema.GroupVersionKind {
 	return core.SchemeGroupVersion.WithKind("Service")
 }
 
+func (_ ServicePrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Type", Type: "string", Description: core.ServiceSpec{}.SwaggerDoc()["type"]},
+		{Name: "Cluster-IP", Type: "string", Description: core.ServiceSpec{}.SwaggerDoc()["clusterIP"]},
+		{Name: "External-IP", Type: "string", Description: core.ServiceSpec{}.SwaggerDoc()["externalIPs"]},
+		{Name: "Port(s)", Type: "string", Description: core.ServiceSpec{}.SwaggerDoc()["ports"]},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Selector", Type: "string", Priority: 1, Description: core.ServiceSpec{}.SwaggerDoc()["selector"]},
+	}
+}
+
 func (p ServicePrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*core.Service)
 	if !ok {
@@ -36,28 +50,36 @@ func (p ServicePrinter) Convert(o runtime.Object) (map[string]interface{}, error
 	row := map[string]interface{}{}
 
 	svcType := obj.Spec.Type
-	internalIP := "<none>"
+	internalIP := ""
 	if len(obj.Spec.ClusterIPs) > 0 {
 		internalIP = obj.Spec.ClusterIPs[0]
 	}
 
 	externalIP := getServiceExternalIP(obj)
 	svcPorts := makePortString(obj.Spec.Ports)
-	if len(svcPorts) == 0 {
-		svcPorts = "<none>"
-	}
 
 	row["Name"] = obj.Name
 	row["Type"] = string(svcType)
-	row["Cluster-IP"] = internalIP
-	row["External-IP"] = externalIP
-	row["Port(s)"] = svcPorts
-	row["Age"] = translateTimestampSince(obj.CreationTimestamp)
+	row["Cluster-IP"] = optionalCell(internalIP, "<none>")
+	row["External-IP"] = externalIPCell(externalIP)
+	row["Port(s)"] = optionalCell(svcPorts, "<none>")
+	row["Age"] = timestampCell(obj.CreationTimestamp)
 	row["Selector"] = labels.FormatLabels(obj.Spec.Selector)
 
 	return row, nil
 }
 
+func (_ ServicePrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*core.Service)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	if getServiceExternalIP(obj) == "<pending>" {
+		return Health{Level: HealthProgressing, Reason: "LoadBalancerPending"}
+	}
+	return Health{Level: HealthOK}
+}
+
 func getServiceExternalIP(svc *core.Service) string {
 	switch svc.Spec.Type {
 	case core.ServiceTypeClusterIP:
@@ -90,6 +112,16 @@ func getServiceExternalIP(svc *core.Service) string {
 	return "<unknown>"
 }
 
+// externalIPCell keeps the addresses returned by getServiceExternalIP as raw
+// value, and nil for placeholders like "<pending>".
+func externalIPCell(externalIP string) Cell {
+	switch externalIP {
+	case "", "<none>", "<pending>", "<unknown>":
+		return Cell{Display: externalIP}
+	}
+	return Cell{Raw: strings.Split(externalIP, ","), Display: externalIP}
+}
+
 // loadBalancerStatusStringer behaves mostly like a string interface and converts the given status to a string.
 // `wide` indicates whether the returned value is meant for --o=wide output. If not, it's clipped to 16 bytes.
 func loadBalancerStatusStringer(s core.LoadBalancerStatus) string {
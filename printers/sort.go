@@ -0,0 +1,182 @@
+package printers
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SortRows sorts the rows of t by a column name or a JSONPath expression
+// evaluated against the source objects. Cells are compared by their raw
+// values: numbers and resource quantities numerically, durations by length,
+// ratios like "1/3" by their fraction and times by their age, so ascending
+// Age lists the newest objects first. Strings are compared as text; their
+// type is never guessed. Missing values sort last and ties are broken by
+// namespace and name.
+func SortRows(t Table, by string, reverse bool) error {
+	keys := make([]interface{}, len(t.Rows))
+	if isJSONPath(by) {
+		jp, err := parseJSONPath(by)
+		if err != nil {
+			return err
+		}
+		for i, row := range t.Rows {
+			if keys[i], err = evalJSONPath(jp, row.Object); err != nil {
+				return err
+			}
+		}
+	} else {
+		col, ok := findColumn(t.Columns, by)
+		if !ok {
+			return fmt.Errorf("column %q not found for %v", by, t.GVK)
+		}
+		for i, row := range t.Rows {
+			keys[i] = row.Cells[col.Name]
+		}
+	}
+
+	idx := make([]int, len(t.Rows))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		a, b := idx[i], idx[j]
+		if c := compareValues(keys[a], keys[b]); c != 0 {
+			// missing values sort last regardless of the direction
+			if reverse && sortKeyOf(keys[a]).kind != sortMissing && sortKeyOf(keys[b]).kind != sortMissing {
+				return c > 0
+			}
+			return c < 0
+		}
+		ns1, name1 := t.Rows[a].namespaceName()
+		ns2, name2 := t.Rows[b].namespaceName()
+		if ns1 != ns2 {
+			return ns1 < ns2
+		}
+		return name1 < name2
+	})
+
+	sorted := make([]Row, len(t.Rows))
+	for i, j := range idx {
+		sorted[i] = t.Rows[j]
+	}
+	copy(t.Rows, sorted)
+	return nil
+}
+
+type sortKind int
+
+const (
+	sortNumber sortKind = iota
+	sortQuantity
+	sortRatio
+	sortTime
+	sortDuration
+	sortString
+	sortMissing
+)
+
+type sortKey struct {
+	kind sortKind
+	num  float64
+	t    time.Time
+	str  string
+}
+
+func compareValues(a, b interface{}) int {
+	return compareKeys(sortKeyOf(a), sortKeyOf(b))
+}
+
+func compareKeys(ka, kb sortKey) int {
+	if ka.kind != kb.kind {
+		if ka.kind < kb.kind {
+			return -1
+		}
+		return 1
+	}
+	switch ka.kind {
+	case sortNumber, sortQuantity, sortRatio, sortDuration:
+		return compareFloats(ka.num, kb.num)
+	case sortTime:
+		// times are shown as ages, so later times are smaller
+		switch {
+		case ka.t.After(kb.t):
+			return -1
+		case ka.t.Before(kb.t):
+			return 1
+		}
+		return 0
+	case sortString:
+		return strings.Compare(ka.str, kb.str)
+	}
+	return 0
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func sortKeyOf(v interface{}) sortKey {
+	switch v := v.(type) {
+	case nil:
+		return sortKey{kind: sortMissing}
+	case Cell:
+		return sortKeyOf(v.Raw)
+	case int:
+		return sortKey{kind: sortNumber, num: float64(v)}
+	case int32:
+		return sortKey{kind: sortNumber, num: float64(v)}
+	case int64:
+		return sortKey{kind: sortNumber, num: float64(v)}
+	case float64:
+		return sortKey{kind: sortNumber, num: v}
+	case bool:
+		if v {
+			return sortKey{kind: sortNumber, num: 1}
+		}
+		return sortKey{kind: sortNumber, num: 0}
+	case PodRestarts:
+		return sortKey{kind: sortNumber, num: float64(v.Count)}
+	case Ratio:
+		if v.Denom == 0 {
+			return sortKey{kind: sortRatio, num: math.Inf(1)}
+		}
+		return sortKey{kind: sortRatio, num: float64(v.Num) / float64(v.Denom)}
+	case resource.Quantity:
+		return sortKey{kind: sortQuantity, num: v.AsApproximateFloat64()}
+	case *resource.Quantity:
+		return sortKeyOf(*v)
+	case time.Duration:
+		return sortKey{kind: sortDuration, num: float64(v)}
+	case []string:
+		return stringSortKey(strings.Join(v, ","))
+	case time.Time:
+		return sortKey{kind: sortTime, t: v}
+	case metav1.Time:
+		return sortKey{kind: sortTime, t: v.Time}
+	case string:
+		return stringSortKey(v)
+	}
+	return stringSortKey(DisplayValue(v))
+}
+
+// stringSortKey returns the key of a string, which is compared as text
+// unless it is a placeholder of a missing value.
+func stringSortKey(s string) sortKey {
+	switch s {
+	case "", "<none>", "<unknown>", "<unset>", "<pending>":
+		return sortKey{kind: sortMissing}
+	}
+	return sortKey{kind: sortString, str: s}
+}
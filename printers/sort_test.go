@@ -0,0 +1,126 @@
+package printers
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestSortRows(t *testing.T) {
+	tests := []struct {
+		by      string
+		reverse bool
+		want    []string
+	}{
+		{by: "Name", want: []string{"a", "b", "c", "d"}},
+		{by: "CPU Requests", want: []string{"c", "b", "d", "a"}},
+		{by: "cpu requests", reverse: true, want: []string{"a", "d", "b", "c"}},
+		{by: "Memory Requests", want: []string{"d", "b", "c", "a"}},
+		// ages: the newest objects first
+		{by: "Age", want: []string{"c", "b", "a", "d"}},
+		{by: "Age", reverse: true, want: []string{"d", "a", "b", "c"}},
+		{by: "Restarts", reverse: true, want: []string{"b", "d", "a", "c"}},
+		// ratios by fraction, ties by namespace and name
+		{by: "Ready", want: []string{"b", "c", "a", "d"}},
+		// missing values last, in both directions
+		{by: "Node", want: []string{"a", "d", "b", "c"}},
+		{by: "Node", reverse: true, want: []string{"b", "a", "d", "c"}},
+		{by: ".spec.nodeName", want: []string{"a", "d", "b", "c"}},
+		{by: "{.metadata.labels.app}", want: []string{"b", "a", "c", "d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			tables, err := NewTables(PriorityExtended, decodeFile(t, "pods.yaml")...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := SortRows(tables[0], tt.by, tt.reverse); err != nil {
+				t.Fatal(err)
+			}
+			if got := rowNames(tables[0]); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortRowsUnknownColumn(t *testing.T) {
+	tables, err := NewTables(PriorityDefault, decodeFile(t, "pods.yaml")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := SortRows(tables[0], "CPU Requests", false); err == nil {
+		t.Error("expected an error for a column left out by the priority")
+	}
+}
+
+func TestSortKeyOf(t *testing.T) {
+	now := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		v    interface{}
+		want sortKey
+	}{
+		{name: "nil", v: nil, want: sortKey{kind: sortMissing}},
+		{name: "placeholder", v: "<none>", want: sortKey{kind: sortMissing}},
+		{name: "empty cell", v: Cell{Display: "<unknown>"}, want: sortKey{kind: sortMissing}},
+		{name: "int64", v: int64(3), want: sortKey{kind: sortNumber, num: 3}},
+		{name: "bool", v: true, want: sortKey{kind: sortNumber, num: 1}},
+		{name: "restarts", v: PodRestarts{Count: 5}, want: sortKey{kind: sortNumber, num: 5}},
+		{name: "ratio", v: ratioCell(1, 4), want: sortKey{kind: sortRatio, num: 0.25}},
+		{name: "ratio of zero", v: Ratio{}, want: sortKey{kind: sortRatio, num: math.Inf(1)}},
+		{name: "millicores", v: resource.MustParse("500m"), want: sortKey{kind: sortQuantity, num: 0.5}},
+		{name: "mebibytes", v: quantityCell(resource.NewQuantity(128*1024*1024, resource.BinarySI)), want: sortKey{kind: sortQuantity, num: 128 * 1024 * 1024}},
+		{name: "duration", v: time.Minute, want: sortKey{kind: sortDuration, num: float64(time.Minute)}},
+		{name: "time", v: Cell{Raw: now, Display: "1d"}, want: sortKey{kind: sortTime, t: now}},
+		// strings are never parsed as numbers, quantities or durations
+		{name: "number text", v: "2", want: sortKey{kind: sortString, str: "2"}},
+		{name: "quantity text", v: "500m", want: sortKey{kind: sortString, str: "500m"}},
+		{name: "ratio text", v: "1/3", want: sortKey{kind: sortString, str: "1/3"}},
+		{name: "list", v: []string{"a", "b"}, want: sortKey{kind: sortString, str: "a,b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sortKeyOf(tt.v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareValues(t *testing.T) {
+	older := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	tests := []struct {
+		name string
+		a, b interface{}
+		want int
+	}{
+		{name: "quantities", a: resource.MustParse("2"), b: resource.MustParse("500m"), want: 1},
+		{name: "binary quantities", a: resource.MustParse("1Gi"), b: resource.MustParse("128Mi"), want: 1},
+		{name: "equal quantities", a: resource.MustParse("1"), b: resource.MustParse("1000m"), want: 0},
+		{name: "newer is younger", a: newer, b: older, want: -1},
+		{name: "text", a: "10", b: "9", want: -1},
+		{name: "missing last", a: nil, b: "a", want: 1},
+		{name: "numbers before text", a: int64(10), b: "a", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compareValues(tt.a, tt.b); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// rowNames returns the names of the rows of t.
+func rowNames(t Table) []string {
+	names := make([]string, len(t.Rows))
+	for i, row := range t.Rows {
+		_, names[i] = row.namespaceName()
+	}
+	return names
+}
@@ -7,6 +7,7 @@ import (
 	"gomodules.xyz/pointer"
 
 	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -20,11 +21,22 @@ func init() {
 type StatefulSetPrinter struct{}
 
 var _ ColumnConverter = StatefulSetPrinter{}
+var _ HealthConverter = StatefulSetPrinter{}
 
 func (_ StatefulSetPrinter) GVK() schema.GroupVersionKind {
 	return apps.SchemeGroupVersion.WithKind("StatefulSet")
 }
 
+func (_ StatefulSetPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: metav1.ObjectMeta{}.SwaggerDoc()["name"]},
+		{Name: "Ready", Type: "string", Description: "Number of the pod with ready state"},
+		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
+		{Name: "Containers", Type: "string", Priority: 1, Description: "Names of each container in the template."},
+		{Name: "Images", Type: "string", Priority: 1, Description: "Images referenced by each container in the template."},
+	}
+}
+
 func (p StatefulSetPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
 	obj, ok := o.(*apps.StatefulSet)
 	if !ok {
@@ -35,10 +47,10 @@ func (p StatefulSetPrinter) Convert(o runtime.Object) (map[string]interface{}, e
 
 	desiredReplicas := obj.Spec.Replicas
 	readyReplicas := obj.Status.ReadyReplicas
-	createTime := translateTimestampSince(obj.CreationTimestamp)
+	createTime := timestampCell(obj.CreationTimestamp)
 
 	row["Name"] = obj.Name
-	row["Ready"] = fmt.Sprintf("%d/%d", int64(readyReplicas), int64(pointer.Int32(desiredReplicas)))
+	row["Ready"] = ratioCell(int64(readyReplicas), int64(pointer.Int32(desiredReplicas)))
 	row["Age"] = createTime
 
 	names, images := layoutContainerCells(obj.Spec.Template.Spec.Containers)
@@ -47,3 +59,11 @@ func (p StatefulSetPrinter) Convert(o runtime.Object) (map[string]interface{}, e
 
 	return row, nil
 }
+
+func (_ StatefulSetPrinter) Health(o runtime.Object) Health {
+	obj, ok := o.(*apps.StatefulSet)
+	if !ok {
+		return Health{Level: HealthUnknown}
+	}
+	return replicasHealth(int64(pointer.Int32(obj.Spec.Replicas)), int64(obj.Status.ReadyReplicas))
+}
@@ -0,0 +1,47 @@
+package printers
+
+import (
+	"fmt"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func init() {
+	Register(StatusPrinter{})
+}
+
+// ref: https://github.com/kubernetes/kubernetes/blob/v1.21.0/pkg/printers/internalversion/printers.go#L441-L445
+
+type StatusPrinter struct{}
+
+var _ ColumnConverter = StatusPrinter{}
+
+func (_ StatusPrinter) GVK() schema.GroupVersionKind {
+	return metav1.Unversioned.WithKind("Status")
+}
+
+func (_ StatusPrinter) Columns() []metav1.TableColumnDefinition {
+	return []metav1.TableColumnDefinition{
+		{Name: "Status", Type: "string", Description: metav1.Status{}.SwaggerDoc()["status"]},
+		{Name: "Reason", Type: "string", Description: metav1.Status{}.SwaggerDoc()["reason"]},
+		{Name: "Message", Type: "string", Description: metav1.Status{}.SwaggerDoc()["message"]},
+	}
+}
+
+func (p StatusPrinter) Convert(o runtime.Object) (map[string]interface{}, error) {
+	obj, ok := o.(*metav1.Status)
+	if !ok {
+		return nil, fmt.Errorf("expected %v, received %v", p.GVK().Kind, reflect.TypeOf(o))
+	}
+
+	row := map[string]interface{}{}
+
+	row["Status"] = obj.Status
+	row["Reason"] = string(obj.Reason)
+	row["Message"] = obj.Message
+
+	return row, nil
+}
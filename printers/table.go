@@ -0,0 +1,153 @@
+package printers
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Table holds the converted rows of objects of a single kind.
+type Table struct {
+	GVK     schema.GroupVersionKind
+	Columns []metav1.TableColumnDefinition
+	Rows    []Row
+}
+
+// Row is a converted object. Object is nil for derived rows that do not
+// correspond to a single object, whose Health is Unknown.
+type Row struct {
+	Object runtime.Object
+	Cells  map[string]interface{}
+	Health Health
+}
+
+// SubTable is a cell that breaks a row down into sub-rows, e.g. the
+// containers of a pod. It is not one of the columns of the table; writers
+// that support sub-rows print them under their row.
+type SubTable struct {
+	Columns []metav1.TableColumnDefinition
+	Rows    []map[string]interface{}
+}
+
+// subTables returns the sub-tables among the cells of row, ordered by key.
+func (r Row) subTables() ([]string, []SubTable) {
+	var keys []string
+	for k, v := range r.Cells {
+		if _, ok := v.(SubTable); ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	subs := make([]SubTable, len(keys))
+	for i, k := range keys {
+		subs[i] = r.Cells[k].(SubTable)
+	}
+	return keys, subs
+}
+
+// NewTables converts objs into one Table per GVK, in the order the kinds
+// are first seen. Columns with a priority higher than the given one are
+// left out.
+func NewTables(priority int32, objs ...runtime.Object) ([]Table, error) {
+	return newTables(priority, converterFor, objs...)
+}
+
+func newTables(priority int32, lookup func(runtime.Object, schema.GroupVersionKind) (ColumnConverter, error), objs ...runtime.Object) ([]Table, error) {
+	var tables []Table
+	var converters []ColumnConverter
+	index := map[schema.GroupVersionKind]int{}
+	for _, o := range objs {
+		gvk := o.GetObjectKind().GroupVersionKind()
+		c, err := lookup(o, gvk)
+		if err != nil {
+			return nil, err
+		}
+		row, err := convert(c, o, priority)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %v: %w", gvk, err)
+		}
+
+		i, ok := index[gvk]
+		if !ok {
+			i = len(tables)
+			index[gvk] = i
+			tables = append(tables, Table{GVK: gvk})
+			converters = append(converters, c)
+		}
+		tables[i].Rows = append(tables[i].Rows, row)
+	}
+	for i := range tables {
+		tables[i].Columns = tableColumns(converters[i], tables[i].Rows, priority)
+	}
+	return tables, nil
+}
+
+// tableColumns returns the columns of the rows of c up to priority.
+func tableColumns(c ColumnConverter, rows []Row, priority int32) []metav1.TableColumnDefinition {
+	cells := make([]map[string]interface{}, len(rows))
+	for i, row := range rows {
+		cells[i] = row.Cells
+	}
+	return filterColumns(columnsOf(c, cells...), priority)
+}
+
+func filterColumns(columns []metav1.TableColumnDefinition, priority int32) []metav1.TableColumnDefinition {
+	result := make([]metav1.TableColumnDefinition, 0, len(columns))
+	for _, col := range columns {
+		if col.Priority <= priority {
+			result = append(result, col)
+		}
+	}
+	return result
+}
+
+// namespaceName returns the namespace and name of the object of a row, or
+// the Name cell of derived rows, if they have one.
+func (r Row) namespaceName() (string, string) {
+	if r.Object != nil {
+		if m, err := meta.Accessor(r.Object); err == nil {
+			return m.GetNamespace(), m.GetName()
+		}
+	}
+	if v, ok := r.Cells["Name"]; ok {
+		return "", DisplayValue(v)
+	}
+	return "", ""
+}
+
+// mergeTables combines tables of different kinds into one, with the union
+// of their columns. Names are prefixed with the kind, like kubectl does
+// when printing more than one kind.
+func mergeTables(tables []Table) Table {
+	if len(tables) == 1 {
+		return tables[0]
+	}
+
+	var result Table
+	seen := map[string]bool{}
+	for _, t := range tables {
+		for _, col := range t.Columns {
+			if !seen[col.Name] {
+				seen[col.Name] = true
+				result.Columns = append(result.Columns, col)
+			}
+		}
+		for _, row := range t.Rows {
+			cells := make(map[string]interface{}, len(row.Cells))
+			for k, v := range row.Cells {
+				cells[k] = v
+			}
+			for _, col := range t.Columns {
+				if col.Format == "name" {
+					cells[col.Name] = formatResourceName(t.GVK.GroupKind(), DisplayValue(row.Cells[col.Name]), true)
+				}
+			}
+			result.Rows = append(result.Rows, Row{Object: row.Object, Cells: cells, Health: row.Health})
+		}
+	}
+	return result
+}
@@ -0,0 +1,149 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const columnSeparator = "   "
+
+// subRowIndent indents the sub-rows of a row, e.g. the containers of a pod.
+const subRowIndent = "    "
+
+// TextWriter writes tables in the format of kubectl get. Sub-rows, like
+// the containers of a pod, are written as an indented table under their
+// row.
+type TextWriter struct {
+	NoHeaders bool
+	// WithKind prefixes names with the kind of the object, like kubectl does
+	// when printing more than one kind.
+	WithKind bool
+	Color    ColorMode
+}
+
+func (w TextWriter) Write(out io.Writer, tables ...Table) error {
+	color := w.Color.enabled(out)
+	for i, t := range tables {
+		if i > 0 && !w.NoHeaders {
+			if _, err := fmt.Fprintln(out); err != nil {
+				return err
+			}
+		}
+
+		lines := make([][]string, 0, len(t.Rows)+1)
+		colors := make([][]string, 0, len(t.Rows)+1)
+		if !w.NoHeaders {
+			lines = append(lines, headerLine(t.Columns))
+			colors = append(colors, make([]string, len(t.Columns)))
+		}
+		for _, row := range t.Rows {
+			line, lineColors := rowLine(t, row, w.WithKind, color)
+			lines = append(lines, line)
+			colors = append(colors, lineColors)
+		}
+
+		widths := columnWidths(lines)
+		first := len(lines) - len(t.Rows)
+		for j, line := range lines {
+			if _, err := io.WriteString(out, formatLine(line, colors[j], widths)); err != nil {
+				return err
+			}
+			if j >= first {
+				if err := w.writeSubRows(out, t.Rows[j-first]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (w TextWriter) writeSubRows(out io.Writer, row Row) error {
+	_, subs := row.subTables()
+	for _, sub := range subs {
+		var lines [][]string
+		if !w.NoHeaders {
+			lines = append(lines, headerLine(sub.Columns))
+		}
+		for _, cells := range sub.Rows {
+			line := make([]string, len(sub.Columns))
+			for j, col := range sub.Columns {
+				line[j] = DisplayValue(cells[col.Name])
+			}
+			lines = append(lines, line)
+		}
+
+		widths := columnWidths(lines)
+		colors := make([]string, len(sub.Columns))
+		for _, line := range lines {
+			if _, err := io.WriteString(out, subRowIndent+formatLine(line, colors, widths)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func headerLine(columns []metav1.TableColumnDefinition) []string {
+	header := make([]string, len(columns))
+	for j, col := range columns {
+		header[j] = strings.ToUpper(col.Name)
+	}
+	return header
+}
+
+// rowLine returns the text of the cells of row, and their colors if color
+// is set.
+func rowLine(t Table, row Row, withKind, color bool) ([]string, []string) {
+	line := make([]string, len(t.Columns))
+	colors := make([]string, len(t.Columns))
+	for j, col := range t.Columns {
+		v := row.Cells[col.Name]
+		line[j] = DisplayValue(v)
+		if col.Format == "name" && withKind {
+			line[j] = formatResourceName(t.GVK.GroupKind(), line[j], true)
+		}
+		if color {
+			colors[j] = cellColor(col.Name, v, row.Health)
+		}
+	}
+	return line, colors
+}
+
+func columnWidths(lines [][]string) []int {
+	var widths []int
+	for _, line := range lines {
+		for j, cell := range line {
+			if j == len(widths) {
+				widths = append(widths, 0)
+			}
+			if n := utf8.RuneCountInString(cell); n > widths[j] {
+				widths[j] = n
+			}
+		}
+	}
+	return widths
+}
+
+// formatLine pads the cells to the given widths. Colors are applied after
+// padding so escape sequences do not count towards the width.
+func formatLine(cells, colors []string, widths []int) string {
+	var sb strings.Builder
+	for j, cell := range cells {
+		if j > 0 {
+			sb.WriteString(columnSeparator)
+		}
+		sb.WriteString(colorize(cell, colors[j]))
+		if j < len(cells)-1 && j < len(widths) {
+			if pad := widths[j] - utf8.RuneCountInString(cell); pad > 0 {
+				sb.WriteString(strings.Repeat(" ", pad))
+			}
+		}
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
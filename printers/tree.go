@@ -0,0 +1,246 @@
+package printers
+
+import (
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TreeNode is an object of an ownership tree with its converted row.
+type TreeNode struct {
+	GVK      schema.GroupVersionKind
+	Columns  []metav1.TableColumnDefinition
+	Row      Row
+	Children []*TreeNode
+	// Orphan is set if the object has owners, but none of them is in the
+	// tree.
+	Orphan bool
+	// Cycle is set if the owner of the object is, directly or not, owned by
+	// the object itself. The node is made a root to break the cycle.
+	Cycle bool
+}
+
+// NewOwnershipTree converts objs and arranges them by their owner
+// references, e.g. Deployment → ReplicaSet → Pod. An object is placed under
+// its controller, or its first owner if none of its owners is a controller.
+// Objects without owners in objs are returned as roots, in the order they
+// are given; children are sorted by kind and name. Owners are matched by
+// UID, or by kind and name for objects without a UID, e.g. manifests.
+func NewOwnershipTree(priority int32, objs ...runtime.Object) ([]*TreeNode, error) {
+	tables, err := NewTables(priority, objs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*TreeNode
+	byUID := map[types.UID]*TreeNode{}
+	byName := map[string]*TreeNode{}
+	metas := map[*TreeNode]metav1.Object{}
+	for _, t := range tables {
+		for _, row := range t.Rows {
+			m, err := meta.Accessor(row.Object)
+			if err != nil {
+				return nil, err
+			}
+			n := &TreeNode{GVK: t.GVK, Columns: t.Columns, Row: row}
+			metas[n] = m
+			if m.GetUID() != "" {
+				byUID[m.GetUID()] = n
+			}
+			byName[ownerKey(t.GVK.GroupKind(), m.GetNamespace(), m.GetName())] = n
+			nodes = append(nodes, n)
+		}
+	}
+	// keep roots in the order the objects are given, not grouped by kind
+	order := map[runtime.Object]int{}
+	for i, o := range objs {
+		order[o] = i
+	}
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return order[nodes[i].Row.Object] < order[nodes[j].Row.Object]
+	})
+
+	owner := map[*TreeNode]*TreeNode{}
+	for _, n := range nodes {
+		m := metas[n]
+		refs := m.GetOwnerReferences()
+		if ref := metav1.GetControllerOfNoCopy(m); ref != nil {
+			refs = append([]metav1.OwnerReference{*ref}, refs...)
+		}
+		for _, ref := range refs {
+			if o := findOwner(ref, m.GetNamespace(), byUID, byName); o != nil {
+				owner[n] = o
+				break
+			}
+		}
+		n.Orphan = len(refs) > 0 && owner[n] == nil
+	}
+
+	// break cycles at the first node of each cycle, in the order of objs
+	for _, n := range nodes {
+		seen := map[*TreeNode]bool{}
+		for cur := n; cur != nil && !seen[cur]; cur = owner[cur] {
+			seen[cur] = true
+			if owner[cur] == n {
+				n.Cycle = true
+				delete(owner, n)
+				break
+			}
+		}
+	}
+
+	var roots []*TreeNode
+	for _, n := range nodes {
+		if o, ok := owner[n]; ok {
+			o.Children = append(o.Children, n)
+		} else {
+			roots = append(roots, n)
+		}
+	}
+	for _, n := range nodes {
+		sort.SliceStable(n.Children, func(i, j int) bool {
+			a, b := n.Children[i], n.Children[j]
+			if a.GVK.Kind != b.GVK.Kind {
+				return a.GVK.Kind < b.GVK.Kind
+			}
+			return metas[a].GetName() < metas[b].GetName()
+		})
+	}
+	return roots, nil
+}
+
+func ownerKey(gk schema.GroupKind, namespace, name string) string {
+	return gk.String() + "/" + namespace + "/" + name
+}
+
+func findOwner(ref metav1.OwnerReference, namespace string, byUID map[types.UID]*TreeNode, byName map[string]*TreeNode) *TreeNode {
+	if ref.UID != "" {
+		if o, ok := byUID[ref.UID]; ok {
+			return o
+		}
+	}
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return nil
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: ref.Kind}
+	// owners are in the same namespace or cluster-scoped
+	for _, ns := range []string{namespace, ""} {
+		if o, ok := byName[ownerKey(gk, ns, ref.Name)]; ok {
+			if uid := o.uid(); ref.UID == "" || uid == "" {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+func (n *TreeNode) uid() types.UID {
+	if m, err := meta.Accessor(n.Row.Object); err == nil {
+		return m.GetUID()
+	}
+	return ""
+}
+
+// TreeWriter writes ownership trees as an indented NAME column followed by
+// the union of the columns of the kinds in the trees. Cells of columns that
+// a kind does not have are left empty.
+type TreeWriter struct {
+	NoHeaders     bool
+	WithNamespace bool
+	Color         ColorMode
+}
+
+func (w TreeWriter) Write(out io.Writer, roots ...*TreeNode) error {
+	color := w.Color.enabled(out)
+
+	var columns []metav1.TableColumnDefinition
+	seen := map[string]bool{}
+	walkTree(roots, func(n *TreeNode, _ string) {
+		for _, col := range n.Columns {
+			if col.Format != "name" && !seen[col.Name] {
+				seen[col.Name] = true
+				columns = append(columns, col)
+			}
+		}
+	})
+
+	var lines, colors [][]string
+	if !w.NoHeaders {
+		header := []string{"NAME"}
+		if w.WithNamespace {
+			header = append([]string{"NAMESPACE"}, header...)
+		}
+		lines = append(lines, append(header, headerLine(columns)...))
+		colors = append(colors, make([]string, len(lines[0])))
+	}
+	walkTree(roots, func(n *TreeNode, prefix string) {
+		ns, name := n.Row.namespaceName()
+		name = prefix + formatResourceName(n.GVK.GroupKind(), name, true)
+		switch {
+		case n.Cycle:
+			name += " (cycle)"
+		case n.Orphan:
+			name += " (orphan)"
+		}
+
+		var line, lineColors []string
+		if w.WithNamespace {
+			line, lineColors = append(line, ns), append(lineColors, "")
+		}
+		line, lineColors = append(line, name), append(lineColors, "")
+
+		for _, col := range columns {
+			text, c := "", ""
+			if _, ok := findColumn(n.Columns, col.Name); ok {
+				v := n.Row.Cells[col.Name]
+				text = DisplayValue(v)
+				if color {
+					c = cellColor(col.Name, v, n.Row.Health)
+				}
+			}
+			line, lineColors = append(line, text), append(lineColors, c)
+		}
+		lines = append(lines, line)
+		colors = append(colors, lineColors)
+	})
+
+	widths := columnWidths(lines)
+	for i, line := range lines {
+		// kinds without the last columns leave trailing blanks
+		text := strings.TrimRight(strings.TrimSuffix(formatLine(line, colors[i], widths), "\n"), " ") + "\n"
+		if _, err := io.WriteString(out, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkTree calls fn for the nodes of the trees in depth-first order, with
+// the prefix that draws the branches leading to each node.
+func walkTree(roots []*TreeNode, fn func(n *TreeNode, prefix string)) {
+	var walk func(nodes []*TreeNode, indent string, root bool)
+	walk = func(nodes []*TreeNode, indent string, root bool) {
+		for i, n := range nodes {
+			last := i == len(nodes)-1
+			switch {
+			case root:
+				fn(n, "")
+				walk(n.Children, "", false)
+			case last:
+				fn(n, indent+"└─")
+				walk(n.Children, indent+"  ", false)
+			default:
+				fn(n, indent+"├─")
+				walk(n.Children, indent+"│ ", false)
+			}
+		}
+	}
+	walk(roots, "", true)
+}
@@ -0,0 +1,86 @@
+package printers
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewOwnershipTree(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want []string
+	}{
+		{
+			name: "owners by uid",
+			file: "tree.yaml",
+			want: []string{
+				"deployment.apps/web",
+				"└─replicaset.apps/web-1",
+				"  ├─pod/web-1-a",
+				"  └─pod/web-1-b",
+				"pod/lost (orphan)",
+				"job.batch/a (cycle)",
+				"└─job.batch/b",
+			},
+		},
+		{
+			// manifests have no uids, and owners must be in the same namespace
+			name: "owners by name",
+			file: "tree-manifests.yaml",
+			want: []string{
+				"deployment.apps/api",
+				"└─replicaset.apps/api-1",
+				"replicaset.apps/api-0 (orphan)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roots, err := NewOwnershipTree(PriorityDefault, decodeFile(t, tt.file)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			walkTree(roots, func(n *TreeNode, prefix string) {
+				_, name := n.Row.namespaceName()
+				line := prefix + formatResourceName(n.GVK.GroupKind(), name, true)
+				switch {
+				case n.Cycle:
+					line += " (cycle)"
+				case n.Orphan:
+					line += " (orphan)"
+				}
+				got = append(got, line)
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestTreeWriter(t *testing.T) {
+	roots, err := NewOwnershipTree(PriorityDefault, decodeFile(t, "tree.yaml")...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := (TreeWriter{WithNamespace: true}).Write(&buf, roots[:1]...); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("got %d lines, want 5:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "NAMESPACE") {
+		t.Errorf("got header %q", lines[0])
+	}
+	for _, line := range lines {
+		if line != strings.TrimRight(line, " ") {
+			t.Errorf("line %q has trailing blanks", line)
+		}
+	}
+}
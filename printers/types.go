@@ -4,3 +4,14 @@ const (
 	// on the node it is (was) running.
 	NodeUnreachablePodReason = "NodeLost"
 )
+
+// Column priorities, see metav1.TableColumnDefinition.Priority.
+const (
+	// PriorityDefault columns are printed by kubectl get.
+	PriorityDefault int32 = 0
+	// PriorityWide columns are printed by kubectl get -o wide.
+	PriorityWide int32 = 1
+	// PriorityExtended columns are not printed by kubectl at all and
+	// are only returned when explicitly asked for.
+	PriorityExtended int32 = 2
+)
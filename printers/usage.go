@@ -0,0 +1,102 @@
+package printers
+
+import (
+	"fmt"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	metrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// usageWarningPercent is the usage of a limit or of the allocatable
+// resources of a node from which rows are marked with a warning.
+const usageWarningPercent = 90
+
+// NewUsageTables converts PodMetrics and NodeMetrics among objs like
+// kubectl top, with percentages computed from the Pods and Nodes among
+// objs. Pods and Nodes that metrics were found for are not converted
+// themselves; the others are. Without metrics among objs it is the same as
+// NewTables.
+func NewUsageTables(priority int32, objs ...runtime.Object) ([]Table, error) {
+	pods := map[types.NamespacedName]*core.Pod{}
+	nodes := map[string]*core.Node{}
+	var usage []runtime.Object
+	for _, o := range objs {
+		switch o := o.(type) {
+		case *core.Pod:
+			pods[types.NamespacedName{Namespace: o.Namespace, Name: o.Name}] = o
+		case *core.Node:
+			nodes[o.Name] = o
+		case *metrics.PodMetrics, *metrics.NodeMetrics:
+			usage = append(usage, o)
+		}
+	}
+	if len(usage) == 0 {
+		return NewTables(priority, objs...)
+	}
+
+	measured := map[runtime.Object]bool{}
+	for _, o := range usage {
+		switch o := o.(type) {
+		case *metrics.PodMetrics:
+			if pod, ok := pods[types.NamespacedName{Namespace: o.Namespace, Name: o.Name}]; ok {
+				measured[pod] = true
+			}
+		case *metrics.NodeMetrics:
+			if node, ok := nodes[o.Name]; ok {
+				measured[node] = true
+			}
+		}
+	}
+	var rest []runtime.Object
+	for _, o := range objs {
+		if !measured[o] {
+			rest = append(rest, o)
+		}
+	}
+	return newTables(priority, func(o runtime.Object, gvk schema.GroupVersionKind) (ColumnConverter, error) {
+		switch o.(type) {
+		case *metrics.PodMetrics:
+			return extend(PodMetricsPrinter{Pods: pods}), nil
+		case *metrics.NodeMetrics:
+			return extend(NodeMetricsPrinter{Nodes: nodes}), nil
+		}
+		return converterFor(o, gvk)
+	}, rest...)
+}
+
+// cpuCell returns the CPU usage in millicores, printed like kubectl top.
+func cpuCell(usage core.ResourceList) Cell {
+	cpu := usage.Cpu().MilliValue()
+	return Cell{Raw: cpu, Display: fmt.Sprintf("%dm", cpu)}
+}
+
+// memoryCell returns the memory usage in bytes, printed in MiB like
+// kubectl top.
+func memoryCell(usage core.ResourceList) Cell {
+	mem := usage.Memory().Value()
+	return Cell{Raw: mem, Display: fmt.Sprintf("%dMi", mem/(1024*1024))}
+}
+
+// usagePercentCell returns the usage of a resource in percent of total, or
+// <none> if total does not have the resource.
+func usagePercentCell(usage, total core.ResourceList, name core.ResourceName) Cell {
+	t, ok := total[name]
+	if !ok || t.IsZero() {
+		return Cell{Display: "<none>"}
+	}
+	u := usage[name]
+	percent := float64(u.MilliValue()) / float64(t.MilliValue()) * 100
+	return Cell{Raw: percent, Display: fmt.Sprintf("%d%%", int64(percent))}
+}
+
+func usageHealth(usage, total core.ResourceList) Health {
+	for _, name := range []core.ResourceName{core.ResourceCPU, core.ResourceMemory} {
+		if c := usagePercentCell(usage, total, name); c.Raw != nil && c.Raw.(float64) >= usageWarningPercent {
+			return Health{Level: HealthWarning, Reason: fmt.Sprintf("%s at %s", name, c.Display)}
+		}
+	}
+	return Health{Level: HealthOK}
+}
@@ -0,0 +1,164 @@
+package printers
+
+import (
+	"reflect"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	metrics "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestNewUsageTables(t *testing.T) {
+	resources := func(cpu, memory string) core.ResourceList {
+		list := core.ResourceList{}
+		if cpu != "" {
+			list[core.ResourceCPU] = resource.MustParse(cpu)
+		}
+		if memory != "" {
+			list[core.ResourceMemory] = resource.MustParse(memory)
+		}
+		return list
+	}
+	pod := func(name string, requests, limits core.ResourceList) *core.Pod {
+		return &core.Pod{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: name},
+			Spec: core.PodSpec{Containers: []core.Container{{
+				Name:      "app",
+				Resources: core.ResourceRequirements{Requests: requests, Limits: limits},
+			}}},
+		}
+	}
+	podMetrics := func(name string, usage ...core.ResourceList) *metrics.PodMetrics {
+		m := &metrics.PodMetrics{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "metrics.k8s.io/v1beta1", Kind: "PodMetrics"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "web", Name: name},
+		}
+		for _, u := range usage {
+			m.Containers = append(m.Containers, metrics.ContainerMetrics{Name: "app", Usage: u})
+		}
+		return m
+	}
+	node := func(name string, allocatable core.ResourceList) *core.Node {
+		return &core.Node{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     core.NodeStatus{Allocatable: allocatable},
+		}
+	}
+	nodeMetrics := func(name string, usage core.ResourceList) *metrics.NodeMetrics {
+		return &metrics.NodeMetrics{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "metrics.k8s.io/v1beta1", Kind: "NodeMetrics"},
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Usage:      usage,
+		}
+	}
+
+	// the cells of these columns are compared, per kind
+	columns := map[string][]string{
+		"PodMetrics":  {"Name", "CPU(cores)", "CPU%", "Memory%", "CPU Limit%", "Memory Limit%"},
+		"NodeMetrics": {"Name", "CPU(cores)", "Memory(bytes)", "CPU%", "Memory%"},
+		"Pod":         {"Name", "Ready"},
+		"Node":        {"Name", "Status"},
+	}
+	tests := []struct {
+		name   string
+		objs   []runtime.Object
+		want   map[string][][]string
+		health map[string]HealthLevel
+	}{
+		{
+			name: "pods with and without metrics",
+			objs: []runtime.Object{
+				pod("api", resources("200m", "256Mi"), resources("400m", "512Mi")),
+				podMetrics("api", resources("150m", "128Mi"), resources("50m", "64Mi")),
+				pod("worker", resources("100m", ""), nil),
+			},
+			want: map[string][][]string{
+				"PodMetrics": {{"api", "200m", "100%", "75%", "50%", "37%"}},
+				"Pod":        {{"worker", "0/1"}},
+			},
+			health: map[string]HealthLevel{"api": HealthOK, "worker": HealthUnknown},
+		},
+		{
+			name: "metrics of a pod that is not in the input",
+			objs: []runtime.Object{
+				podMetrics("gone", resources("5m", "10Mi")),
+			},
+			want: map[string][][]string{
+				"PodMetrics": {{"gone", "5m", "<unknown>", "<unknown>", "<unknown>", "<unknown>"}},
+			},
+			health: map[string]HealthLevel{"gone": HealthUnknown},
+		},
+		{
+			name: "pod without requests and limits near its limit",
+			objs: []runtime.Object{
+				pod("batch", nil, resources("", "1Gi")),
+				podMetrics("batch", resources("1", "950Mi")),
+			},
+			want: map[string][][]string{
+				"PodMetrics": {{"batch", "1000m", "<none>", "<none>", "<none>", "92%"}},
+			},
+			health: map[string]HealthLevel{"batch": HealthWarning},
+		},
+		{
+			name: "nodes with and without metrics",
+			objs: []runtime.Object{
+				node("n1", resources("4", "16Gi")),
+				node("n2", resources("8", "32Gi")),
+				nodeMetrics("n1", resources("1500m", "4Gi")),
+				nodeMetrics("n3", resources("250m", "1Gi")),
+			},
+			want: map[string][][]string{
+				"NodeMetrics": {
+					{"n1", "1500m", "4096Mi", "37%", "25%"},
+					{"n3", "250m", "1024Mi", "<unknown>", "<unknown>"},
+				},
+				"Node": {{"n2", "Unknown"}},
+			},
+			health: map[string]HealthLevel{"n1": HealthOK, "n2": HealthUnknown, "n3": HealthUnknown},
+		},
+		{
+			name: "no metrics",
+			objs: []runtime.Object{
+				node("n1", resources("4", "16Gi")),
+				pod("api", nil, nil),
+			},
+			want: map[string][][]string{
+				"Node": {{"n1", "Unknown"}},
+				"Pod":  {{"api", "0/1"}},
+			},
+			health: map[string]HealthLevel{"n1": HealthUnknown, "api": HealthUnknown},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tables, err := NewUsageTables(PriorityWide, tt.objs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := map[string][][]string{}
+			for _, table := range tables {
+				kind := table.GVK.Kind
+				for _, row := range table.Rows {
+					var line []string
+					for _, col := range columns[kind] {
+						line = append(line, DisplayValue(row.Cells[col]))
+					}
+					got[kind] = append(got[kind], line)
+
+					name := DisplayValue(row.Cells["Name"])
+					if want, ok := tt.health[name]; ok && row.Health.Level != want {
+						t.Errorf("%s %s has health %v, want %s", kind, name, row.Health, want)
+					}
+				}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got rows %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,10 +2,12 @@ package printers
 
 import (
 	"bytes"
+	"strings"
 	"time"
 
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -20,9 +22,11 @@ func translateTimestampSince(timestamp metav1.Time) string {
 }
 
 // Lay out all the containers on one line if use wide output.
-func layoutContainerCells(containers []core.Container) (names string, images string) {
+func layoutContainerCells(containers []core.Container) (names Cell, images Cell) {
 	var namesBuffer bytes.Buffer
 	var imagesBuffer bytes.Buffer
+	nameList := make([]string, 0, len(containers))
+	imageList := make([]string, 0, len(containers))
 
 	for i, container := range containers {
 		namesBuffer.WriteString(container.Name)
@@ -31,8 +35,20 @@ func layoutContainerCells(containers []core.Container) (names string, images str
 			namesBuffer.WriteString(",")
 			imagesBuffer.WriteString(",")
 		}
+		nameList = append(nameList, container.Name)
+		imageList = append(imageList, container.Image)
 	}
-	return namesBuffer.String(), imagesBuffer.String()
+	return Cell{Raw: nameList, Display: namesBuffer.String()}, Cell{Raw: imageList, Display: imagesBuffer.String()}
+}
+
+// formatResourceName receives a resource kind, name, and boolean specifying
+// whether or not to update the current name to "kind/name"
+func formatResourceName(kind schema.GroupKind, name string, withKind bool) string {
+	if !withKind || kind.Empty() {
+		return name
+	}
+
+	return strings.ToLower(kind.String()) + "/" + name
 }
 
 func printBoolPtr(value *bool) string {
@@ -50,3 +66,55 @@ func printBool(value bool) string {
 
 	return "False"
 }
+
+// podRequestsAndLimits returns a dictionary of all defined resources summed up for all
+// containers of the pod. If pod overhead is non-nil, the pod overhead is added to the
+// total container resource requests and to the total container limits which have a
+// non-zero quantity.
+func podRequestsAndLimits(pod *core.Pod) (reqs, limits core.ResourceList) {
+	reqs, limits = core.ResourceList{}, core.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResourceList(reqs, container.Resources.Requests)
+		addResourceList(limits, container.Resources.Limits)
+	}
+	// init containers define the minimum of any resource
+	for _, container := range pod.Spec.InitContainers {
+		maxResourceList(reqs, container.Resources.Requests)
+		maxResourceList(limits, container.Resources.Limits)
+	}
+
+	// Add overhead for running a pod to the sum of requests and to non-zero limits:
+	if pod.Spec.Overhead != nil {
+		addResourceList(reqs, pod.Spec.Overhead)
+
+		for name, quantity := range pod.Spec.Overhead {
+			if value, ok := limits[name]; ok {
+				value.Add(quantity)
+				limits[name] = value
+			}
+		}
+	}
+	return
+}
+
+// addResourceList adds the resources in newList to list
+func addResourceList(list, newList core.ResourceList) {
+	for name, quantity := range newList {
+		if value, ok := list[name]; !ok {
+			list[name] = quantity.DeepCopy()
+		} else {
+			value.Add(quantity)
+			list[name] = value
+		}
+	}
+}
+
+// maxResourceList sets list to the greater of list/newList for every resource
+// either list
+func maxResourceList(list, new core.ResourceList) {
+	for name, quantity := range new {
+		if value, ok := list[name]; !ok || quantity.Cmp(value) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
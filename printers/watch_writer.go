@@ -0,0 +1,82 @@
+package printers
+
+import (
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+const eventColumn = "Event"
+
+// WatchWriter prints a row per watch event, like kubectl get -w. Headers
+// are printed once per kind and column widths are fixed by the header and
+// the first row, so later rows line up with the ones already printed.
+type WatchWriter struct {
+	Out       io.Writer
+	Priority  int32
+	NoHeaders bool
+	// WithEventType adds an EVENT column, like kubectl get --output-watch-events.
+	WithEventType bool
+	Color         ColorMode
+
+	widths map[schema.GroupVersionKind][]int
+}
+
+func NewWatchWriter(out io.Writer, priority int32) *WatchWriter {
+	return &WatchWriter{Out: out, Priority: priority}
+}
+
+func (w *WatchWriter) Write(e watch.Event) error {
+	switch e.Type {
+	case watch.Bookmark:
+		return nil
+	case watch.Error:
+		return watchError(e.Object)
+	}
+
+	tables, err := NewTables(w.Priority, e.Object)
+	if err != nil {
+		return err
+	}
+	t := tables[0]
+	if w.WithEventType {
+		t.Columns = append([]metav1.TableColumnDefinition{{Name: eventColumn, Type: "string"}}, t.Columns...)
+		t.Rows[0].Cells[eventColumn] = string(e.Type)
+	}
+
+	line, colors := rowLine(t, t.Rows[0], false, w.Color.enabled(w.Out))
+	if w.widths == nil {
+		w.widths = map[schema.GroupVersionKind][]int{}
+	}
+	widths, ok := w.widths[t.GVK]
+	if !ok {
+		header := headerLine(t.Columns)
+		widths = columnWidths([][]string{header, line})
+		if w.WithEventType && widths[0] < len(watch.Modified) {
+			// leave room for the longest event type
+			widths[0] = len(watch.Modified)
+		}
+		w.widths[t.GVK] = widths
+		if !w.NoHeaders {
+			if _, err := io.WriteString(w.Out, formatLine(header, make([]string, len(header)), widths)); err != nil {
+				return err
+			}
+		}
+	}
+	// a cell wider than its column shifts the rest of its own line only
+	_, err = io.WriteString(w.Out, formatLine(line, colors, widths))
+	return err
+}
+
+// watchError returns the error carried by the object of an ERROR event.
+func watchError(o runtime.Object) error {
+	if status, ok := o.(*metav1.Status); ok {
+		return apierrors.FromObject(status)
+	}
+	return fmt.Errorf("watch error: %v", o)
+}